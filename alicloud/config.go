@@ -16,6 +16,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
 	aliecs "github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	alikms "github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/aliyun-log-go-sdk"
@@ -69,6 +70,7 @@ type AliyunClient struct {
 	fcconn     *fc.Client
 	slsconn    *sls.Client
 	aliecsconn *aliecs.Client
+	alikmsconn *alikms.Client
 }
 
 // Client for AliyunClient
@@ -152,6 +154,10 @@ func (c *Config) Client() (*AliyunClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	alikmsconn, err := c.aliKmsConn()
+	if err != nil {
+		return nil, err
+	}
 
 	return &AliyunClient{
 		Region:     c.Region,
@@ -173,6 +179,7 @@ func (c *Config) Client() (*AliyunClient, error) {
 		fcconn:     fcconn,
 		slsconn:    slsconn,
 		aliecsconn: aliecsconn,
+		alikmsconn: alikmsconn,
 	}, nil
 }
 
@@ -350,6 +357,14 @@ func (c *Config) aliEcsConn() (*aliecs.Client, error) {
 	return aliecs.NewClientWithAccessKey(c.RegionId, c.AccessKey, c.SecretKey)
 }
 
+func (c *Config) aliKmsConn() (*alikms.Client, error) {
+	endpoint := LoadEndpoint(c.RegionId, KMSCode)
+	if endpoint != "" {
+		endpoints.AddEndpointMapping(c.RegionId, string(KMSCode), endpoint)
+	}
+	return alikms.NewClientWithOptions(c.RegionId, getSdkConfig(), c.getAuthCredential(true))
+}
+
 func getSdkConfig() *sdk.Config {
 	return sdk.NewConfig().
 		WithMaxRetryTime(5).
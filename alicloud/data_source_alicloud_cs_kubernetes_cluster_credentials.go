@@ -0,0 +1,81 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudCSKubernetesClusterCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCSKubernetesClusterCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			//Computed value
+			"cluster_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_cert": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_cert_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_ca_cert": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_cert_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCSKubernetesClusterCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	clusterId := d.Get("cluster_id").(string)
+
+	cluster, err := client.csconn.DescribeCluster(clusterId)
+	if err != nil {
+		if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
+			return GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Cluster", clusterId))
+		}
+		return err
+	}
+
+	creds, err := client.DescribeKubernetesClusterCredentials(clusterId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(clusterId)
+	d.Set("cluster_name", cluster.Name)
+
+	return setKubernetesClusterCredentials(d, creds, true)
+}
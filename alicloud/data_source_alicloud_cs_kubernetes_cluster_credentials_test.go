@@ -0,0 +1,59 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCSKubernetesClusterCredentialsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCSKubernetesClusterCredentialsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_cs_kubernetes_cluster_credentials.creds"),
+					resource.TestCheckResourceAttr("data.alicloud_cs_kubernetes_cluster_credentials.creds", "cluster_name", "terraform-test-for-k8s-creds"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credentials.creds", "kube_config"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credentials.creds", "client_cert"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credentials.creds", "client_key"),
+					resource.TestCheckResourceAttrSet("data.alicloud_cs_kubernetes_cluster_credentials.creds", "cluster_ca_cert"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCSKubernetesClusterCredentialsDataSourceBasic = `
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 1
+	memory_size = 2
+}
+
+resource "alicloud_cs_kubernetes" "k8s" {
+  name = "terraform-test-for-k8s-creds"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+  new_nat_gateway = true
+  master_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 1
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+}
+
+data "alicloud_cs_kubernetes_cluster_credentials" "creds" {
+	cluster_id = "${alicloud_cs_kubernetes.k8s.id}"
+}
+`
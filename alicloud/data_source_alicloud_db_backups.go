@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudDBBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudDBBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDBTime(DBBackupTimeLayout),
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDBTime(DBBackupTimeLayout),
+			},
+			"backup_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Automated", "Manual"}),
+			},
+			"backup_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Success", "Failed"}),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backup_db_names": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudDBBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := rds.CreateDescribeBackupsRequest()
+	request.RegionId = getRegionId(d, meta)
+	request.DBInstanceId = d.Get("instance_id").(string)
+	// The time range is required by the API, so it defaults to the latest days of the default backup retention period.
+	endTime := time.Now().UTC()
+	if v, ok := d.GetOk("end_time"); ok {
+		t, err := time.Parse(DBBackupTimeLayout, v.(string))
+		if err != nil {
+			return fmt.Errorf("Parsing end_time %s got an error: %#v", v.(string), err)
+		}
+		endTime = t
+	}
+	request.EndTime = endTime.Format(DBBackupTimeLayout)
+	if v, ok := d.GetOk("start_time"); ok {
+		request.StartTime = v.(string)
+	} else {
+		request.StartTime = endTime.AddDate(0, 0, -DBBackupDefaultRetentionDays).Format(DBBackupTimeLayout)
+	}
+	request.BackupMode = d.Get("backup_mode").(string)
+	request.BackupStatus = d.Get("backup_status").(string)
+
+	backups, err := client.DescribeDBBackups(request)
+	if err != nil {
+		return fmt.Errorf("DescribeBackups got an error: %#v", err)
+	}
+
+	return dbBackupsDescription(d, backups)
+}
+
+func dbBackupsDescription(d *schema.ResourceData, backups []rds.Backup) error {
+	var ids []string
+	var s []map[string]interface{}
+
+	for _, backup := range backups {
+		mapping := map[string]interface{}{
+			"id":                backup.BackupId,
+			"instance_id":       backup.DBInstanceId,
+			"backup_mode":       backup.BackupMode,
+			"backup_method":     backup.BackupMethod,
+			"backup_type":       backup.BackupType,
+			"backup_status":     backup.BackupStatus,
+			"backup_size":       backup.BackupSize,
+			"backup_db_names":   backup.BackupDBNames,
+			"backup_start_time": backup.BackupStartTime,
+			"backup_end_time":   backup.BackupEndTime,
+			"backup_location":   backup.BackupLocation,
+			"host_instance_id":  backup.HostInstanceID,
+		}
+
+		ids = append(ids, backup.BackupId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("backups", s); err != nil {
+		return err
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
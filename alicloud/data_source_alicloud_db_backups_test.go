@@ -0,0 +1,82 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudDBBackupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBBackupsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_backups.backups"),
+					resource.TestCheckResourceAttr("data.alicloud_db_backups.backups", "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudDBBackupsDataSource_defaultTime(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudDBBackupsDataSourceDefaultTimeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_db_backups.backups"),
+					resource.TestMatchResourceAttr("data.alicloud_db_backups.backups", "backups.#", regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_backups.backups", "backups.0.id"),
+					resource.TestCheckResourceAttr("data.alicloud_db_backups.backups", "backups.0.backup_status", "Success"),
+					resource.TestCheckResourceAttrSet("data.alicloud_db_backups.backups", "backups.0.backup_end_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudDBBackupsDataSourceConfig = `
+data "alicloud_db_backups" "backups" {
+  instance_id = "${alicloud_db_instance.db.id}"
+  start_time  = "2018-01-01T00:00Z"
+  end_time    = "2018-01-02T00:00Z"
+}
+
+resource "alicloud_db_instance" "db" {
+  engine               = "MySQL"
+  engine_version       = "5.6"
+  instance_type        = "rds.mysql.t1.small"
+  instance_storage     = "10"
+  instance_charge_type = "Postpaid"
+}
+`
+
+const testAccCheckAlicloudDBBackupsDataSourceDefaultTimeConfig = `
+data "alicloud_db_backups" "backups" {
+  instance_id   = "${alicloud_db_backup_policy.db.instance_id}"
+  backup_status = "Success"
+}
+
+resource "alicloud_db_backup_policy" "db" {
+  instance_id = "${alicloud_db_instance.db.id}"
+}
+
+resource "alicloud_db_instance" "db" {
+  engine               = "MySQL"
+  engine_version       = "5.6"
+  instance_type        = "rds.mysql.t1.small"
+  instance_storage     = "10"
+  instance_charge_type = "Postpaid"
+}
+`
@@ -3,9 +3,9 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 
-	"github.com/aliyun/fc-go-sdk"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -92,6 +92,31 @@ func dataSourceAlicloudFcFunctions() *schema.Resource {
 							Type:     schema.TypeMap,
 							Computed: true,
 						},
+						"initializer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"initialization_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_concurrency": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ca_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"custom_container_image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"layers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"resource_type": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -106,26 +131,25 @@ func dataSourceAlicloudFcFunctions() *schema.Resource {
 func dataSourceAlicloudFcFunctionsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
-	listFunctionsInput := fc.NewListFunctionsInput(d.Get("service_name").(string))
+	// The functions are listed by common request to get the runtime settings which are not supported by the fc-go-sdk.
+	request := NewFcCommonRequest("/services/%s/functions", d.Get("service_name").(string))
 	if prefix, ok := d.GetOk("prefix"); ok {
-		listFunctionsInput.WithPrefix(prefix.(string))
+		request.QueryParams.Set("prefix", prefix.(string))
 	}
 	if startKey, ok := d.GetOk("start_key"); ok {
-		listFunctionsInput.WithStartKey(startKey.(string))
+		request.QueryParams.Set("startKey", startKey.(string))
 	}
 	if nextToken, ok := d.GetOk("next_token"); ok {
-		listFunctionsInput.WithNextToken(nextToken.(string))
+		request.QueryParams.Set("nextToken", nextToken.(string))
 	}
 	if limit, ok := d.GetOk("limit"); ok {
-		if limit, err := strconv.ParseInt(strconv.Itoa(limit.(int)), 10, 32); err != nil {
-			return fmt.Errorf("List functions of function compute got an error: %#v", err)
-		} else {
-			listFunctionsInput.WithLimit(int32(limit))
-		}
+		request.QueryParams.Set("limit", strconv.Itoa(limit.(int)))
 	}
 
-	listFunctionsOutput, err := client.fcconn.ListFunctions(listFunctionsInput)
-	if err != nil {
+	var listFunctionsOutput struct {
+		Functions []FcFunction `json:"functions"`
+	}
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &listFunctionsOutput); err != nil {
 		return fmt.Errorf("List functions of function compute got an error: %#v", err)
 	} else {
 		log.Printf("[DEBUG] alicloud_fc - Functions found: %#v", listFunctionsOutput.Functions)
@@ -134,21 +158,42 @@ func dataSourceAlicloudFcFunctionsRead(d *schema.ResourceData, meta interface{})
 		var s []map[string]interface{}
 		for _, function := range listFunctionsOutput.Functions {
 			mapping := map[string]interface{}{
-				"id":                    *function.FunctionID,
-				"name":                  *function.FunctionName,
-				"status":                "Available",
-				"creation_time":         *function.CreatedTime,
-				"description":           *function.Description,
-				"runtime":               *function.Runtime,
-				"handler":               *function.Handler,
-				"timeout":               strconv.Itoa(int(*function.Timeout)),
-				"memory_size":           strconv.Itoa(int(*function.MemorySize)),
-				"code_size":             strconv.FormatInt(*function.CodeSize, 10),
-				"last_modified_time":    *function.LastModifiedTime,
-				"environment_variables": function.EnvironmentVariables,
-				"resource_type":         "alicloud_fc_function",
+				"id":                     function.FunctionId,
+				"name":                   function.FunctionName,
+				"status":                 "Available",
+				"creation_time":          function.CreatedTime,
+				"description":            function.Description,
+				"runtime":                function.Runtime,
+				"handler":                function.Handler,
+				"timeout":                strconv.Itoa(int(function.Timeout)),
+				"memory_size":            strconv.Itoa(int(function.MemorySize)),
+				"code_size":              strconv.FormatInt(function.CodeSize, 10),
+				"last_modified_time":     function.LastModifiedTime,
+				"environment_variables":  function.EnvironmentVariables,
+				"initializer":            "",
+				"initialization_timeout": 0,
+				"instance_concurrency":   0,
+				"ca_port":                0,
+				"custom_container_image": "",
+				"layers":                 function.Layers,
+				"resource_type":          "alicloud_fc_function",
+			}
+			if function.Initializer != nil {
+				mapping["initializer"] = *function.Initializer
+			}
+			if function.InitializationTimeout != nil {
+				mapping["initialization_timeout"] = int(*function.InitializationTimeout)
+			}
+			if function.InstanceConcurrency != nil {
+				mapping["instance_concurrency"] = int(*function.InstanceConcurrency)
+			}
+			if function.CAPort != nil {
+				mapping["ca_port"] = int(*function.CAPort)
+			}
+			if function.CustomContainerConfig != nil {
+				mapping["custom_container_image"] = function.CustomContainerConfig.Image
 			}
-			ids = append(ids, *function.FunctionID)
+			ids = append(ids, function.FunctionId)
 			s = append(s, mapping)
 		}
 
@@ -0,0 +1,63 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAlicloudKmsCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudKmsCiphertextRead,
+
+		Schema: map[string]*schema.Schema{
+			"ciphertext_blob": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"encryption_context": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+
+			//Computed value
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plaintext": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).alikmsconn
+
+	args := kms.CreateDecryptRequest()
+	args.CiphertextBlob = d.Get("ciphertext_blob").(string)
+
+	if v, ok := d.GetOk("encryption_context"); ok {
+		context, err := convertKmsEncryptionContext(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		args.EncryptionContext = context
+	}
+
+	resp, err := conn.Decrypt(args)
+	if err != nil {
+		return fmt.Errorf("Decrypt got an error: %#v.", err)
+	}
+
+	d.SetId(dataResourceIdHash([]string{args.CiphertextBlob}))
+	d.Set("key_id", resp.KeyId)
+	d.Set("plaintext", resp.Plaintext)
+
+	return nil
+}
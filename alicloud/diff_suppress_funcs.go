@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"strings"
@@ -236,3 +237,38 @@ func cmsDimensionsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) b
 	sort.Strings(news)
 	return reflect.DeepEqual(olds, news)
 }
+
+func kmsRotationIntervalDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("automatic_rotation").(string) != KmsRotationEnabled {
+		return true
+	}
+	o, oerr := kmsRotationIntervalInSeconds(old)
+	n, nerr := kmsRotationIntervalInSeconds(new)
+	return oerr == nil && nerr == nil && o == n
+}
+
+func jsonStringDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(o, n)
+}
+
+// kubernetesVersionDiffSuppressFunc ignores the '-aliyun.N' suffix appended by the cluster when the kubernetes version
+// is set without it. The full version is compared when it is set with the suffix, so that a patch can be upgraded to.
+func kubernetesVersionDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" || strings.Contains(new, "-") {
+		return old == new
+	}
+	return strings.Split(old, "-")[0] == new
+}
+
+func floatStringDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	o, oerr := strconv.ParseFloat(old, 64)
+	n, nerr := strconv.ParseFloat(new, 64)
+	return oerr == nil && nerr == nil && o == n
+}
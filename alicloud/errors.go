@@ -87,6 +87,8 @@ const (
 	IncorrectCapacityMinSize                    = "IncorrectCapacity.MinSize"
 	ScalingActivityInProgress                   = "ScalingActivityInProgress"
 	EssThrottling                               = "Throttling"
+	InvalidLifecycleHookIdNotExist              = "InvalidLifecycleHookId.NotExist"
+	NotificationConfigurationNotExist           = "NotificationConfigurationNotExist"
 	// rds
 	InvalidDBInstanceIdNotFound            = "InvalidDBInstanceId.NotFound"
 	InvalidDBNameNotFound                  = "InvalidDBName.NotFound"
@@ -150,7 +152,8 @@ const (
 	ServiceBusy = "ServiceBusy"
 
 	// KMS
-	ForbiddenKeyNotFound = "Forbidden.KeyNotFound"
+	ForbiddenKeyNotFound   = "Forbidden.KeyNotFound"
+	ForbiddenAliasNotFound = "Forbidden.AliasNotFound"
 	// RAM
 	InvalidRamRoleNotFound       = "InvalidRamRole.NotFound"
 	RoleAttachmentUnExpectedJson = "unexpected end of JSON input"
@@ -161,18 +164,29 @@ const (
 
 	// CS
 	ErrorClusterNameAlreadyExist = "ErrorClusterNameAlreadyExist"
+	ErrorNodePoolNotFound        = "ErrorNodePoolNotFound"
 	ApplicationNotFound          = "Not Found"
 	ApplicationErrorIgnore       = "Unable to reach primary cluster manager"
 	ApplicationConfirmConflict   = "Conflicts with unconfirmed updates for operation"
 
 	// fc
-	ServiceNotFound  = "ServiceNotFound"
-	FunctionNotFound = "FunctionNotFound"
+	ServiceNotFound         = "ServiceNotFound"
+	FunctionNotFound        = "FunctionNotFound"
+	TriggerNotFound         = "TriggerNotFound"
+	VersionNotFound         = "VersionNotFound"
+	AliasNotFound           = "AliasNotFound"
+	ProvisionConfigNotFound = "ProvisionConfigNotFound"
+	DomainNameNotFound      = "DomainNameNotFound"
 
 	// log
-	ProjectNotFound      = "ProjectNotExist"
-	LogStoreNotFound     = "LogStoreNotExist"
-	MachineGroupNotFound = "MachinGroupNotExist"
+	ProjectNotFound       = "ProjectNotExist"
+	LogStoreNotFound      = "LogStoreNotExist"
+	MachineGroupNotFound  = "MachinGroupNotExist"
+	SavedSearchNotFound   = "SavedSearchNotExist"
+	DashboardNotFound     = "DashboardNotExist"
+	JobNotFound           = "JobNotExist"
+	ShipperNotFound       = "ShipperNotExist"
+	ConsumerGroupNotFound = "ConsumerGroupNotExist"
 
 	// AutoSnapshotPolicy
 	ParameterInvalid  = "ParameterInvalid"
@@ -183,6 +197,9 @@ const (
 
 var SlbIsBusy = []string{"SystemBusy", "OperationBusy", "ServiceIsStopping", "BackendServer.configuring", "ServiceIsConfiguring"}
 
+// The errors of describing the TDE, SSL and SQL collector settings of the engines which do not support them.
+var DBInstanceSecurityNotSupported = []string{"InvaildEngineInRegion.ValueNotSupported", "InstanceEngineType.NotSupport", "OperationDenied.DBInstanceType"}
+
 // An Error represents a custom error for Terraform failure response
 type ProviderError struct {
 	errorCode string
@@ -228,11 +245,15 @@ func NotFoundError(err error) bool {
 	}
 	if e, ok := err.(*fc.ServiceError); ok &&
 		(e.ErrorCode == InstanceNotFound || e.ErrorCode == RamInstanceNotFound || e.ErrorCode == ServiceNotFound ||
-			e.ErrorCode == FunctionNotFound || strings.Contains(strings.ToLower(e.ErrorMessage), NotExist)) {
+			e.ErrorCode == FunctionNotFound || e.ErrorCode == TriggerNotFound || e.ErrorCode == VersionNotFound ||
+			e.ErrorCode == AliasNotFound || e.ErrorCode == ProvisionConfigNotFound || e.ErrorCode == DomainNameNotFound ||
+			strings.Contains(strings.ToLower(e.ErrorMessage), NotExist)) {
 		return true
 	}
 	if e, ok := err.(*sls.Error); ok &&
 		(e.Code == ProjectNotFound || e.Code == LogStoreNotFound || e.Code == MachineGroupNotFound ||
+			e.Code == SavedSearchNotFound || e.Code == DashboardNotFound || e.Code == JobNotFound || e.Code == ShipperNotFound ||
+			e.Code == ConsumerGroupNotFound ||
 			strings.Contains(strings.ToLower(e.Message), NotExist)) {
 		return true
 	}
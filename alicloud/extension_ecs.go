@@ -57,6 +57,48 @@ const (
 	KubernetesMasterNumber  = 3
 	KubernetesVersion       = "1.9.3"
 	KubernetesDockerVersion = "17.06.2-ce-1"
+
+	KubernetesClusterType        = "Kubernetes"
+	ManagedKubernetesClusterType = "ManagedKubernetes"
+	// A multi-AZ kubernetes cluster spreads its masters and workers across 3 vswitches.
+	KubernetesMultiAZVSwitchNumber = 3
+)
+
+type KubernetesNodePoolState string
+
+const (
+	KubernetesNodePoolActive   = KubernetesNodePoolState("active")
+	KubernetesNodePoolScaling  = KubernetesNodePoolState("scaling")
+	KubernetesNodePoolUpdating = KubernetesNodePoolState("updating")
+	KubernetesNodePoolFailed   = KubernetesNodePoolState("failed")
+)
+
+const (
+	KubernetesNodeSelectionOldest   = "oldest"
+	KubernetesNodeSelectionNewest   = "newest"
+	KubernetesNodeSelectionSpecific = "specific"
+)
+
+// The nodes created along with the kubernetes cluster come from its ROS stack.
+const KubernetesNodeSourceROS = "ROS"
+
+const (
+	KubernetesNodeReady = "Ready"
+	SwarmNodeRunning    = "running"
+)
+
+const (
+	KubernetesNetworkFlannel = "flannel"
+	KubernetesNetworkTerway  = "terway"
+
+	KubernetesProxyModeIptables = "iptables"
+	KubernetesProxyModeIpvs     = "ipvs"
+)
+
+const (
+	KubernetesTaintNoSchedule       = "NoSchedule"
+	KubernetesTaintPreferNoSchedule = "PreferNoSchedule"
+	KubernetesTaintNoExecute        = "NoExecute"
 )
 
 type RenewalStatus string
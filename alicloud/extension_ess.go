@@ -0,0 +1,50 @@
+package alicloud
+
+type LifecycleTransition string
+
+const (
+	ScaleOutTransition = LifecycleTransition("SCALE_OUT")
+	ScaleInTransition  = LifecycleTransition("SCALE_IN")
+)
+
+type LifecycleActionResult string
+
+const (
+	ContinueLifecycleAction = LifecycleActionResult("CONTINUE")
+	AbandonLifecycleAction  = LifecycleActionResult("ABANDON")
+)
+
+const (
+	ScaleOutSuccessNotification      = "AUTOSCALING:SCALE_OUT_SUCCESS"
+	ScaleOutErrorNotification        = "AUTOSCALING:SCALE_OUT_ERROR"
+	ScaleInSuccessNotification       = "AUTOSCALING:SCALE_IN_SUCCESS"
+	ScaleInErrorNotification         = "AUTOSCALING:SCALE_IN_ERROR"
+	ScaleRejectNotification          = "AUTOSCALING:SCALE_REJECT"
+	ScaleOutStartNotification        = "AUTOSCALING:SCALE_OUT_START"
+	ScaleInStartNotification         = "AUTOSCALING:SCALE_IN_START"
+	ScheduleTaskExpiringNotification = "AUTOSCALING:SCHEDULE_TASK_EXPIRING"
+)
+
+const (
+	SimpleScalingRule         = "SimpleScalingRule"
+	TargetTrackingScalingRule = "TargetTrackingScalingRule"
+	StepScalingRule           = "StepScalingRule"
+)
+
+const (
+	EssSystemMetric = "system"
+	EssCustomMetric = "custom"
+)
+
+type MultiAZPolicy string
+
+const (
+	PriorityMultiAZPolicy      = MultiAZPolicy("PRIORITY")
+	BalanceMultiAZPolicy       = MultiAZPolicy("BALANCE")
+	CostOptimizedMultiAZPolicy = MultiAZPolicy("COST_OPTIMIZED")
+)
+
+const EssAutoCreatedInstance = "AutoCreated"
+
+// timeout for replacing all of the outdated instances of a scaling group
+const EssInstanceRefreshTimeout = 3600
@@ -0,0 +1,23 @@
+package alicloud
+
+import (
+	"github.com/aliyun/fc-go-sdk"
+)
+
+// The trigger types which are not defined in the fc-go-sdk
+const (
+	TriggerTypeMnsTopic  = "mns_topic"
+	TriggerTypeCdnEvents = "cdn_events"
+)
+
+// The runtime of the function which runs a custom container image
+const FcRuntimeCustomContainer = "custom-container"
+
+// The legacy config fields of each trigger type, which are replaced by the typed trigger blocks
+var fcLegacyTriggerConfigKeys = map[string][]string{
+	string(fc.TRIGGER_TYPE_TIMER): {"config_payload", "config_cron_expression"},
+	string(fc.TRIGGER_TYPE_OSS):   {"config_events", "config_filter_key_prefix", "config_filter_key_suffix"},
+	string(fc.TRIGGER_TYPE_LOG): {"config_source_logstore", "config_job_interval", "config_job_max_retry_time",
+		"config_function_parameter", "config_log_project", "config_log_logstore"},
+	string(fc.TRIGGER_TYPE_HTTP): {"config_auth_type", "config_methods"},
+}
@@ -1,5 +1,7 @@
 package alicloud
 
+const KmsApiVersion20160120 = "2016-01-20"
+
 type KeyState string
 
 const (
@@ -7,3 +9,8 @@ const (
 	Disabled        = KeyState("Disabled")
 	PendingDeletion = KeyState("PendingDeletion")
 )
+
+const (
+	KmsRotationEnabled  = "Enabled"
+	KmsRotationDisabled = "Disabled"
+)
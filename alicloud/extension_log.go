@@ -0,0 +1,57 @@
+package alicloud
+
+type LogNotificationType string
+
+const (
+	LogNotificationDingTalk      = LogNotificationType("DingTalk")
+	LogNotificationEmail         = LogNotificationType("Email")
+	LogNotificationWebhook       = LogNotificationType("Webhook")
+	LogNotificationMNS           = LogNotificationType("MNS")
+	LogNotificationMessageCenter = LogNotificationType("MessageCenter")
+	LogNotificationSMS           = LogNotificationType("SMS")
+)
+
+const (
+	LogJobTypeAlert          = "Alert"
+	LogScheduleTypeFixedRate = "FixedRate"
+)
+
+const (
+	LogJobEnabled  = "Enabled"
+	LogJobDisabled = "Disabled"
+)
+
+const LogShardReadWrite = "readwrite"
+
+const (
+	LogJobTypeETL           = "ETL"
+	LogScheduleTypeResident = "Resident"
+	LogEtlSinkTypeLog       = "AliyunLOG"
+)
+
+const (
+	LogEtlJobRunning = "RUNNING"
+	LogEtlJobStopped = "STOPPED"
+)
+
+const (
+	LogShipperTargetOss  = "oss"
+	LogShipperTargetOdps = "odps"
+)
+
+// The input types of alicloud_log_config. The json input is a file input whose log type is json_log.
+const (
+	LogConfigInputFile   = "file"
+	LogConfigInputJson   = "json"
+	LogConfigInputPlugin = "plugin"
+)
+
+const (
+	LogTypeCommonRegex = "common_reg_log"
+	LogTypeJson        = "json_log"
+)
+
+const (
+	LogConfigPluginKey   = "plugin"
+	LogConfigAdvancedKey = "advanced"
+)
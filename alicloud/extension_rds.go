@@ -9,6 +9,34 @@ const (
 	PostgreSQL = Engine("PostgreSQL")
 )
 
+type DBInstanceType string
+
+const (
+	PrimaryDBInstance  = DBInstanceType("Primary")
+	ReadonlyDBInstance = DBInstanceType("Readonly")
+)
+
+const (
+	SSLActionOpen   = "Open"
+	SSLActionClose  = "Close"
+	SSLActionUpdate = "Update"
+
+	TDEEnabled  = "Enabled"
+	TDEDisabled = "Disabled"
+
+	SQLCollectorEnabled  = "Enabled"
+	SQLCollectorDisabled = "Disabled"
+)
+
+const (
+	// The UTC time formats required by the RDS backup APIs
+	DBRestoreTimeLayout = "2006-01-02T15:04:05Z"
+	DBBackupTimeLayout  = "2006-01-02T15:04Z"
+)
+
+// The default backup retention period of RDS instances
+const DBBackupDefaultRetentionDays = 7
+
 type DBAccountPrivilege string
 
 const (
@@ -37,3 +65,5 @@ var CHARACTER_SET_NAME = []string{
 	"utf8", "gbk", "latin1", "utf8mb4",
 	"Chinese_PRC_CI_AS", "Chinese_PRC_CS_AS", "SQL_Latin1_General_CP1_CI_AS", "SQL_Latin1_General_CP1_CS_AS", "Chinese_PRC_BIN",
 }
+
+var SQL_COLLECTOR_RETENTION = []int{30, 180, 365, 1095, 1825}
@@ -4,6 +4,8 @@ import (
 	"github.com/denverdino/aliyungo/slb"
 )
 
+const BackendServerHealthNormal = "normal"
+
 type Listener struct {
 	slb.HTTPListenerType
 
@@ -68,6 +68,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_eips":           dataSourceAlicloudEips(),
 			"alicloud_key_pairs":      dataSourceAlicloudKeyPairs(),
 			"alicloud_kms_keys":       dataSourceAlicloudKmsKeys(),
+			"alicloud_kms_ciphertext": dataSourceAlicloudKmsCiphertext(),
 			"alicloud_dns_domains":    dataSourceAlicloudDnsDomains(),
 			"alicloud_dns_groups":     dataSourceAlicloudDnsGroups(),
 			"alicloud_dns_records":    dataSourceAlicloudDnsRecords(),
@@ -75,36 +76,38 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_dns_domain_groups":  dataSourceAlicloudDnsGroups(),
 			"alicloud_dns_domain_records": dataSourceAlicloudDnsRecords(),
 			// alicloud_ram_account_alias has been deprecated
-			"alicloud_ram_account_alias":       dataSourceAlicloudRamAccountAlias(),
-			"alicloud_ram_account_aliases":     dataSourceAlicloudRamAccountAlias(),
-			"alicloud_ram_groups":              dataSourceAlicloudRamGroups(),
-			"alicloud_ram_users":               dataSourceAlicloudRamUsers(),
-			"alicloud_ram_roles":               dataSourceAlicloudRamRoles(),
-			"alicloud_ram_policies":            dataSourceAlicloudRamPolicies(),
-			"alicloud_security_groups":         dataSourceAlicloudSecurityGroups(),
-			"alicloud_security_group_rules":    dataSourceAlicloudSecurityGroupRules(),
-			"alicloud_db_instances":            dataSourceAlicloudDBInstances(),
-			"alicloud_auto_snapshot_policies":  dataSourceAlicloudAutoSnapshotPolicies(),
-			"alicloud_fc_services":             dataSourceAlicloudFcServices(),
-			"alicloud_fc_functions":            dataSourceAlicloudFcFunctions(),
-			"alicloud_fc_triggers":             dataSourceAlicloudFcTriggers(),
-			"alicloud_fc_invokes":              dataSourceAlicloudFcInvokes(),
-			"alicloud_log_projects":            dataSourceAlicloudLogProjects(),
-			"alicloud_log_stores":              dataSourceAlicloudLogStores(),
-			"alicloud_log_configs":             dataSourceAlicloudLogConfigs(),
-			"alicloud_log_machinegroups":       dataSourceAlicloudLogMachineGroups(),
-			"alicloud_cms_alarms":              dataSourceAlicloudCmsAlarms(),
-			"alicloud_cms_contact_groups":      dataSourceAlicloudCmsContactGroups(),
-			"alicloud_router_interfaces":       dataSourceAlicloudRouterInterfaces(),
-			"alicloud_commands":                dataSourceAlicloudCommands(),
-			"alicloud_command_invokes":         dataSourceAlicloudCommandInvokes(),
-			"alicloud_command_invoke_results":  dataSourceAlicloudCommandInvokeResults(),
-			"alicloud_cms_app_groups":          dataSourceAlicloudCmsAppGroups(),
-			"alicloud_base_encode":             dataSourceAlicloudBaseEncode(),
-			"alicloud_disks":                   dataSourceAlicloudDisks(),
-			"alicloud_image_share_permissions": dataSourceAlicloudImageSharePermissions(),
-			"alicloud_dummy_resource":          dataSourceAlicloudDummyResource(),
-			"alicloud_dummy_parameters":        dataSourceAlicloudDummyParameters(),
+			"alicloud_ram_account_alias":                 dataSourceAlicloudRamAccountAlias(),
+			"alicloud_ram_account_aliases":               dataSourceAlicloudRamAccountAlias(),
+			"alicloud_ram_groups":                        dataSourceAlicloudRamGroups(),
+			"alicloud_ram_users":                         dataSourceAlicloudRamUsers(),
+			"alicloud_ram_roles":                         dataSourceAlicloudRamRoles(),
+			"alicloud_ram_policies":                      dataSourceAlicloudRamPolicies(),
+			"alicloud_security_groups":                   dataSourceAlicloudSecurityGroups(),
+			"alicloud_security_group_rules":              dataSourceAlicloudSecurityGroupRules(),
+			"alicloud_db_instances":                      dataSourceAlicloudDBInstances(),
+			"alicloud_db_backups":                        dataSourceAlicloudDBBackups(),
+			"alicloud_auto_snapshot_policies":            dataSourceAlicloudAutoSnapshotPolicies(),
+			"alicloud_fc_services":                       dataSourceAlicloudFcServices(),
+			"alicloud_fc_functions":                      dataSourceAlicloudFcFunctions(),
+			"alicloud_fc_triggers":                       dataSourceAlicloudFcTriggers(),
+			"alicloud_fc_invokes":                        dataSourceAlicloudFcInvokes(),
+			"alicloud_log_projects":                      dataSourceAlicloudLogProjects(),
+			"alicloud_log_stores":                        dataSourceAlicloudLogStores(),
+			"alicloud_log_configs":                       dataSourceAlicloudLogConfigs(),
+			"alicloud_log_machinegroups":                 dataSourceAlicloudLogMachineGroups(),
+			"alicloud_cms_alarms":                        dataSourceAlicloudCmsAlarms(),
+			"alicloud_cms_contact_groups":                dataSourceAlicloudCmsContactGroups(),
+			"alicloud_router_interfaces":                 dataSourceAlicloudRouterInterfaces(),
+			"alicloud_commands":                          dataSourceAlicloudCommands(),
+			"alicloud_command_invokes":                   dataSourceAlicloudCommandInvokes(),
+			"alicloud_command_invoke_results":            dataSourceAlicloudCommandInvokeResults(),
+			"alicloud_cms_app_groups":                    dataSourceAlicloudCmsAppGroups(),
+			"alicloud_base_encode":                       dataSourceAlicloudBaseEncode(),
+			"alicloud_disks":                             dataSourceAlicloudDisks(),
+			"alicloud_image_share_permissions":           dataSourceAlicloudImageSharePermissions(),
+			"alicloud_cs_kubernetes_cluster_credentials": dataSourceAlicloudCSKubernetesClusterCredentials(),
+			"alicloud_dummy_resource":                    dataSourceAlicloudDummyResource(),
+			"alicloud_dummy_parameters":                  dataSourceAlicloudDummyParameters(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"alicloud_instance":                  resourceAliyunInstance(),
@@ -119,10 +122,14 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_db_backup_policy":          resourceAlicloudDBBackupPolicy(),
 			"alicloud_db_connection":             resourceAlicloudDBConnection(),
 			"alicloud_db_instance":               resourceAlicloudDBInstance(),
+			"alicloud_db_readonly_instance":      resourceAlicloudDBReadonlyInstance(),
 			"alicloud_ess_scaling_group":         resourceAlicloudEssScalingGroup(),
 			"alicloud_ess_scaling_configuration": resourceAlicloudEssScalingConfiguration(),
 			"alicloud_ess_scaling_rule":          resourceAlicloudEssScalingRule(),
 			"alicloud_ess_schedule":              resourceAlicloudEssSchedule(),
+			"alicloud_ess_lifecycle_hook":        resourceAlicloudEssLifecycleHook(),
+			"alicloud_ess_notification":          resourceAlicloudEssNotification(),
+			"alicloud_ess_alarm":                 resourceAlicloudEssAlarm(),
 			"alicloud_ess_attachment":            resourceAlicloudEssAttachment(),
 			"alicloud_vpc":                       resourceAliyunVpc(),
 			"alicloud_nat_gateway":               resourceAliyunNatGateway(),
@@ -147,6 +154,8 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_key_pair":            resourceAlicloudKeyPair(),
 			"alicloud_key_pair_attachment": resourceAlicloudKeyPairAttachment(),
 			"alicloud_kms_key":             resourceAlicloudKmsKey(),
+			"alicloud_kms_ciphertext":      resourceAlicloudKmsCiphertext(),
+			"alicloud_kms_alias":           resourceAlicloudKmsAlias(),
 			"alicloud_ram_user":            resourceAlicloudRamUser(),
 			"alicloud_ram_access_key":      resourceAlicloudRamAccessKey(),
 			"alicloud_ram_login_profile":   resourceAlicloudRamLoginProfile(),
@@ -164,6 +173,10 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_cs_application":                   resourceAlicloudCSApplication(),
 			"alicloud_cs_swarm":                         resourceAlicloudCSSwarm(),
 			"alicloud_cs_kubernetes":                    resourceAlicloudCSKubernetes(),
+			"alicloud_cs_managed_kubernetes":            resourceAlicloudCSManagedKubernetes(),
+			"alicloud_cs_kubernetes_node_pool":          resourceAlicloudCSKubernetesNodePool(),
+			"alicloud_cs_kubernetes_node_attachment":    resourceAlicloudCSKubernetesNodeAttachment(),
+			"alicloud_cs_swarm_node_attachment":         resourceAlicloudCSSwarmNodeAttachment(),
 			"alicloud_cdn_domain":                       resourceAlicloudCdnDomain(),
 			"alicloud_router_interface":                 resourceAlicloudRouterInterface(),
 			"alicloud_router_interface_connect":         resourceAlicloudRouterInterfaceConnect(),
@@ -174,12 +187,23 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_auto_snapshot_policy":             resourceAlicloudAutoSnapshotPolicy(),
 			"alicloud_auto_snapshot_policy_application": resourceAlicloudAutoSnapshotPolicyApplication(),
 			"alicloud_fc_trigger":                       resourceAlicloudFcTrigger(),
+			"alicloud_fc_version":                       resourceAlicloudFcVersion(),
+			"alicloud_fc_alias":                         resourceAlicloudFcAlias(),
+			"alicloud_fc_provisioned_config":            resourceAlicloudFcProvisionedConfig(),
+			"alicloud_fc_custom_domain":                 resourceAlicloudFcCustomDomain(),
 			"alicloud_log_project":                      resourceAlicloudLogProject(),
 			"alicloud_log_store":                        resourceAlicloudLogStore(),
 			"alicloud_log_config":                       resourceAlicloudLogConfig(),
 			"alicloud_log_machinegroup":                 resourceAlicloudLogMachineGroup(),
 			"alicloud_log_configtomachinegroup":         resourceAlicloudLogConfigToMachineGroup(),
 			"alicloud_log_storeindex":                   resourceAlicloudLogStoreIndex(),
+			"alicloud_log_saved_search":                 resourceAlicloudLogSavedSearch(),
+			"alicloud_log_dashboard":                    resourceAlicloudLogDashboard(),
+			"alicloud_log_alert":                        resourceAlicloudLogAlert(),
+			"alicloud_log_oss_shipper":                  resourceAlicloudLogOssShipper(),
+			"alicloud_log_odps_shipper":                 resourceAlicloudLogOdpsShipper(),
+			"alicloud_log_etl_job":                      resourceAlicloudLogEtlJob(),
+			"alicloud_log_consumer_group":               resourceAlicloudLogConsumerGroup(),
 			"alicloud_command":                          resourceAlicloudCommand(),
 			"alicloud_command_invoke":                   resourceAlicloudCommandInvoke(),
 			"alicloud_cms_app_group":                    resourceAlicloudCmsAppGroup(),
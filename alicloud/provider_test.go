@@ -44,6 +44,13 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+func testAccPreCheckWithAccountId(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("ALICLOUD_ACCOUNT_ID"); v == "" {
+		t.Fatal("ALICLOUD_ACCOUNT_ID must be set for acceptance tests which need the account ID")
+	}
+}
+
 func testAccCheckAlicloudDataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
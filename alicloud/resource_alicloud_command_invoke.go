@@ -42,6 +42,18 @@ func resourceAlicloudCommandInvoke() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"lifecycle_hook_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"timed"},
+			},
+			"lifecycle_action_result": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ContinueLifecycleAction,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(ContinueLifecycleAction), string(AbandonLifecycleAction)}),
+			},
 			// Computed values
 			"alicloud_command_invoke": {
 				Type:     schema.TypeList,
@@ -217,6 +229,16 @@ func resourceAlicloudCommandInvokeCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(invokeCommandResponse.InvokeId)
 
+	// The instances are waiting for the lifecycle hook, so let the scaling activity go on after the command finished.
+	if hookId, ok := d.GetOk("lifecycle_hook_id"); ok {
+		if err := waitForCommandInvokeFinished(client, d.Id()); err != nil {
+			return err
+		}
+		if err := client.EssCompleteLifecycleActions(hookId.(string), instanceIdsStr, LifecycleActionResult(d.Get("lifecycle_action_result").(string))); err != nil {
+			return err
+		}
+	}
+
 	return resourceAlicloudCommandInvokeUpdate(d, meta)
 }
 
@@ -311,6 +333,12 @@ func resourceAlicloudCommandInvokeUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("frequency") && !d.IsNewResource() {
 		return fmt.Errorf("Updating command invoke got an error: %#v", "Modifying the parameter of frequency is not supported.")
 	}
+	if d.HasChange("lifecycle_hook_id") && !d.IsNewResource() {
+		return fmt.Errorf("Updating command invoke got an error: %#v", "Modifying the parameter of lifecycle_hook_id is not supported.")
+	}
+	if d.HasChange("lifecycle_action_result") && !d.IsNewResource() {
+		return fmt.Errorf("Updating command invoke got an error: %#v", "Modifying the parameter of lifecycle_action_result is not supported.")
+	}
 
 	d.Partial(false)
 
@@ -341,3 +369,25 @@ func resourceAlicloudCommandInvokeDelete(d *schema.ResourceData, meta interface{
 	})
 
 }
+
+func waitForCommandInvokeFinished(client *AliyunClient, invokeId string) error {
+	describeInvocationsRequest := ecs.CreateDescribeInvocationsRequest()
+	describeInvocationsRequest.InvokeId = invokeId
+
+	return resource.Retry(time.Duration(DefaultLongTimeout)*time.Second, func() *resource.RetryError {
+		describeInvocationsResponse, err := client.aliecsconn.DescribeInvocations(describeInvocationsRequest)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("DescribeInvocations got an error: %#v.", err))
+		}
+		for _, invocation := range describeInvocationsResponse.Invocations.Invocation {
+			switch invocation.InvokeStatus {
+			case "Finished":
+				return nil
+			case "Failed", "PartialFailed", "Stopped":
+				return resource.NonRetryableError(fmt.Errorf("Command invoke %s is %s and the lifecycle actions are left to the default result.", invokeId, invocation.InvokeStatus))
+			}
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Waitting for command invoke %s finished timeout.", invokeId))
+	})
+}
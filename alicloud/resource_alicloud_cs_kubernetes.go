@@ -1,11 +1,16 @@
 package alicloud
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
-	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/denverdino/aliyungo/ecs"
 	"github.com/denverdino/aliyungo/slb"
@@ -39,16 +44,28 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 				ConflictsWith: []string{"name"},
 			},
 			"availability_zone": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vswitch_ids"},
 			},
 			"vswitch_id": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vswitch_ids"},
+			},
+			"vswitch_ids": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				MinItems:      KubernetesMultiAZVSwitchNumber,
+				MaxItems:      KubernetesMultiAZVSwitchNumber,
+				ConflictsWith: []string{"vswitch_id", "availability_zone"},
 			},
 			"new_nat_gateway": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -70,6 +87,33 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 				Optional: true,
 				Default:  3,
 			},
+			"remove_nodes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"selection": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  KubernetesNodeSelectionNewest,
+							ValidateFunc: validateAllowedStringValue([]string{
+								KubernetesNodeSelectionOldest, KubernetesNodeSelectionNewest, KubernetesNodeSelectionSpecific}),
+						},
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"drain_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validateIntegerInRange(60, 3600),
+						},
+					},
+				},
+			},
 			"password": &schema.Schema{
 				Type:      schema.TypeString,
 				Required:  true,
@@ -119,6 +163,59 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"kubernetes_version": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateKubernetesVersion,
+				DiffSuppressFunc: kubernetesVersionDiffSuppressFunc,
+			},
+			"network_plugin": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					KubernetesNetworkFlannel, KubernetesNetworkTerway}),
+			},
+			"node_cidr_mask": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(24, 28),
+			},
+			"proxy_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					KubernetesProxyModeIptables, KubernetesProxyModeIpvs}),
+			},
+			"user_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"addons": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"config": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateJsonContent,
+							DiffSuppressFunc: jsonStringDiffSuppressFunc,
+						},
+					},
+				},
+			},
 			"is_outdated": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -144,6 +241,10 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -200,6 +301,40 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"kube_config": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_cert": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_cert_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_ca_cert": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_cert_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -208,15 +343,25 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 	client := meta.(*AliyunClient)
 	conn := client.csconn
 
-	args, err := buildKunernetesArgs(d, meta)
-	if err != nil {
-		return err
-	}
-
-	cluster, err := conn.CreateKubernetesCluster(getRegion(d, meta), args)
-
-	if err != nil {
-		return fmt.Errorf("Creating Kubernetes Cluster got an error: %#v", err)
+	var cluster cs.ClusterCreationResponse
+	if _, ok := d.GetOk("vswitch_ids"); ok {
+		args, err := buildKubernetesMultiAZArgs(d, meta)
+		if err != nil {
+			return err
+		}
+		cluster, err = client.CreateContainerCluster(getRegion(d, meta), args)
+		if err != nil {
+			return fmt.Errorf("Creating Kubernetes Cluster got an error: %#v", err)
+		}
+	} else {
+		args, err := buildKunernetesArgs(d, meta)
+		if err != nil {
+			return err
+		}
+		cluster, err = client.CreateContainerCluster(getRegion(d, meta), args)
+		if err != nil {
+			return fmt.Errorf("Creating Kubernetes Cluster got an error: %#v", err)
+		}
 	}
 
 	d.SetId(cluster.ClusterID)
@@ -229,19 +374,49 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).csconn
+	client := meta.(*AliyunClient)
+	conn := client.csconn
 	d.Partial(true)
-	if d.HasChange("worker_number") && !d.IsNewResource() {
-		// Ensure instance_type is generation three
-		args, err := buildKunernetesArgs(d, meta)
-		if err != nil {
+	if d.HasChange("kubernetes_version") && !d.IsNewResource() {
+		o, n := d.GetChange("kubernetes_version")
+		if compareKubernetesVersion(n.(string), o.(string)) < 0 {
+			return fmt.Errorf("Kubernetes version can not be downgraded from %s to %s.", o.(string), n.(string))
+		}
+		if err := client.UpgradeKubernetesCluster(d.Id(), o.(string), n.(string)); err != nil {
+			return fmt.Errorf("Upgrade Cluster got an error: %#v", err)
+		}
+		if err := conn.WaitForClusterAsyn(d.Id(), cs.Running, 3600); err != nil {
+			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
+		}
+		d.SetPartial("kubernetes_version")
+	}
+
+	o, n := d.GetChange("worker_number")
+	if v, ok := d.GetOk("remove_nodes"); ok && n.(int) < o.(int) && !d.IsNewResource() {
+		if err := removeKubernetesWorkers(d, meta, v.([]interface{})[0].(map[string]interface{}), o.(int)-n.(int)); err != nil {
 			return err
 		}
-		if err := conn.ResizeKubernetes(d.Id(), args); err != nil {
-			return fmt.Errorf("Resize Cluster got an error: %#v", err)
+		d.SetPartial("worker_number")
+		d.SetPartial("remove_nodes")
+	} else if d.HasChange("worker_number") && !d.IsNewResource() {
+		if _, ok := d.GetOk("vswitch_ids"); ok {
+			if err := client.ResizeContainerCluster(d.Id(), buildKubernetesMultiAZScaleArgs(d)); err != nil {
+				return fmt.Errorf("Resize Cluster got an error: %#v", err)
+			}
+		} else {
+			// Ensure instance_type is generation three
+			args, err := buildKunernetesArgs(d, meta)
+			if err != nil {
+				return err
+			}
+			// Addons are only installed when creating and are managed separately afterwards
+			args.Addons = nil
+			if err := client.ResizeContainerCluster(d.Id(), args); err != nil {
+				return fmt.Errorf("Resize Cluster got an error: %#v", err)
+			}
 		}
 
-		err = conn.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+		err := conn.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
 
 		if err != nil {
 			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
@@ -262,6 +437,41 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 		d.SetPartial("name")
 		d.SetPartial("name_prefix")
 	}
+
+	if d.HasChange("addons") && !d.IsNewResource() {
+		o, n := d.GetChange("addons")
+		oldAddons := expandKubernetesAddons(o.([]interface{}))
+		newAddons := expandKubernetesAddons(n.([]interface{}))
+
+		var install, uninstall []KubernetesAddon
+		for name, addon := range newAddons {
+			old, ok := oldAddons[name]
+			if !ok {
+				install = append(install, addon)
+				continue
+			}
+			if old.Config != addon.Config {
+				if err := client.ModifyKubernetesAddon(d.Id(), addon); err != nil {
+					return fmt.Errorf("Modify Cluster Addon %s got an error: %#v", name, err)
+				}
+			}
+		}
+		for name, addon := range oldAddons {
+			if _, ok := newAddons[name]; !ok {
+				uninstall = append(uninstall, addon)
+			}
+		}
+		if err := client.UninstallKubernetesAddons(d.Id(), uninstall); err != nil {
+			return fmt.Errorf("Uninstall Cluster Addons got an error: %#v", err)
+		}
+		if err := client.InstallKubernetesAddons(d.Id(), install); err != nil {
+			return fmt.Errorf("Install Cluster Addons got an error: %#v", err)
+		}
+		if err := conn.WaitForClusterAsyn(d.Id(), cs.Running, 3600); err != nil {
+			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
+		}
+		d.SetPartial("addons")
+	}
 	d.Partial(false)
 
 	return resourceAlicloudCSKubernetesRead(d, meta)
@@ -281,61 +491,77 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set("name", cluster.Name)
-	// Each k8s cluster contains 3 master nodes
-	d.Set("worker_number", cluster.Size-KubernetesMasterNumber)
-	d.Set("vswitch_id", cluster.VSwitchID)
+	// The vswitches of a multi-AZ cluster are joined with comma.
+	if vswitchIds := strings.Split(cluster.VSwitchID, COMMA_SEPARATED); len(vswitchIds) > 1 {
+		d.Set("vswitch_id", vswitchIds[0])
+		d.Set("vswitch_ids", vswitchIds)
+	} else {
+		d.Set("vswitch_id", cluster.VSwitchID)
+	}
 	d.Set("vpc_id", cluster.VPCID)
 	d.Set("security_group_id", cluster.SecurityGroupID)
 
-	var nodes []map[string]interface{}
-	var master, worker cs.KubernetesNodeType
-
-	pageNumber := 1
-	for {
-		result, pagination, err := client.csconn.GetKubernetesClusterNodes(d.Id(), common.Pagination{PageNumber: pageNumber, PageSize: 50})
+	detail, err := client.DescribeKubernetesClusterDetail(d.Id())
+	if err != nil {
+		return fmt.Errorf("[ERROR] DescribeKubernetesClusterDetail %s got an error: %#v.", d.Id(), err)
+	}
+	if detail.CurrentVersion != "" {
+		d.Set("kubernetes_version", detail.CurrentVersion)
+	}
+	if v := detail.Parameters["Network"]; v != "" {
+		d.Set("network_plugin", v)
+	}
+	if v, err := strconv.Atoi(detail.Parameters["NodeCIDRMask"]); err == nil {
+		d.Set("node_cidr_mask", v)
+	}
+	if v := detail.Parameters["ProxyMode"]; v != "" {
+		d.Set("proxy_mode", v)
+	}
+	if v := detail.Parameters["UserData"]; v != "" {
+		userData, err := base64.StdEncoding.DecodeString(v)
 		if err != nil {
-			return fmt.Errorf("[ERROR] GetKubernetesClusterNodes got an error: %#v.", err)
+			return fmt.Errorf("[ERROR] Decoding user data of Kubernetes Cluster %s got an error: %#v.", d.Id(), err)
+		}
+		d.Set("user_data", string(userData))
+	}
+	if detail.MetaData != "" {
+		var metaData KubernetesClusterMetaData
+		if err := json.Unmarshal([]byte(detail.MetaData), &metaData); err != nil {
+			return fmt.Errorf("[ERROR] Parsing meta data of Kubernetes Cluster %s got an error: %#v.", d.Id(), err)
 		}
+		d.Set("addons", flattenKubernetesAddons(d.Get("addons").([]interface{}), metaData.Addons))
+	}
 
-		if pageNumber == 1 && (len(result) == 0 || result[0].InstanceId == "") {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				tmp, _, err := client.csconn.GetKubernetesClusterNodes(d.Id(), common.Pagination{PageNumber: pageNumber, PageSize: 50})
-				if err != nil {
-					return resource.NonRetryableError(fmt.Errorf("[ERROR] GetKubernetesClusterNodes got an error: %#v.", err))
-				}
-				if len(result) > 0 && result[0].InstanceId != "" {
-					result = tmp
-					return nil
-				}
-				return resource.RetryableError(fmt.Errorf("[ERROR] There is no any nodes in kubernetes cluster %s.", d.Id()))
-			})
-			if err != nil {
-				return err
-			}
+	var nodes []map[string]interface{}
+	var master, worker KubernetesClusterNode
+	// The nodes of node pools and the attached instances are not managed by worker_number.
+	var otherNumber int64
 
+	result, err := client.DescribeKubernetesClusterNodes(d.Id())
+	if err != nil {
+		return err
+	}
+	for _, node := range result {
+		if node.InstanceRole == "Worker" && !isKubernetesClusterOwnWorker(node) {
+			otherNumber++
 		}
-
-		for _, node := range result {
-			mapping := map[string]interface{}{
-				"id":         node.InstanceId,
-				"name":       node.InstanceName,
-				"private_ip": node.IpAddress[0],
-				"role":       node.InstanceRole,
-			}
-			nodes = append(nodes, mapping)
-			if master.InstanceId == "" && node.InstanceRole == "Master" {
-				master = node
-			} else if worker.InstanceId == "" && node.InstanceRole == "Worker" {
-				worker = node
-			}
+		mapping := map[string]interface{}{
+			"id":            node.InstanceId,
+			"name":          node.InstanceName,
+			"private_ip":    node.IpAddress[0],
+			"role":          node.InstanceRole,
+			"creation_time": node.CreationTime,
 		}
-
-		if len(result) < pagination.PageSize {
-			break
+		nodes = append(nodes, mapping)
+		if master.InstanceId == "" && node.InstanceRole == "Master" {
+			master = node
+		} else if worker.InstanceId == "" && node.InstanceRole == "Worker" {
+			worker = node
 		}
-		pageNumber += 1
 	}
 	d.Set("nodes", nodes)
+	// Each k8s cluster contains 3 master nodes
+	d.Set("worker_number", cluster.Size-KubernetesMasterNumber-otherNumber)
 
 	d.Set("master_instance_type", master.InstanceType)
 	if disks, _, err := client.ecsconn.DescribeDisks(&ecs.DescribeDisksArgs{
@@ -400,7 +626,14 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 		d.Set("nat_gateway_id", nat.NatGateways.NatGateway[0].NatGatewayId)
 	}
 
-	return nil
+	// The credentials are not essential to the cluster, so failing to get them does not break the refreshing.
+	creds, err := client.DescribeKubernetesClusterCredentials(d.Id())
+	if err != nil {
+		log.Printf("[WARN] DescribeKubernetesClusterCredentials %s got an error: %#v.", d.Id(), err)
+		return nil
+	}
+
+	return setKubernetesClusterCredentials(d, creds, false)
 }
 
 func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
@@ -434,7 +667,7 @@ func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}
 	})
 }
 
-func buildKunernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.KubernetesCreationArgs, error) {
+func buildKunernetesArgs(d *schema.ResourceData, meta interface{}) (*KubernetesCreationExtendedArgs, error) {
 	client := meta.(*AliyunClient)
 
 	// Ensure instance_type is valid
@@ -457,7 +690,8 @@ func buildKunernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.Kubernet
 		clusterName = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
 	}
 
-	stackArgs := &cs.KubernetesStackArgs{
+	stackArgs := &KubernetesStackExtendedArgs{}
+	stackArgs.KubernetesStackArgs = cs.KubernetesStackArgs{
 		MasterInstanceType:       d.Get("master_instance_type").(string),
 		WorkerInstanceType:       d.Get("worker_instance_type").(string),
 		Password:                 d.Get("password").(string),
@@ -467,7 +701,7 @@ func buildKunernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.Kubernet
 		WorkerSystemDiskCategory: ecs.DiskCategory(d.Get("worker_disk_category").(string)),
 		WorkerSystemDiskSize:     int64(d.Get("worker_disk_size").(int)),
 		SNatEntry:                d.Get("new_nat_gateway").(bool),
-		KubernetesVersion:        KubernetesVersion,
+		KubernetesVersion:        getKubernetesVersion(d),
 		DockerVersion:            KubernetesDockerVersion,
 		ContainerCIDR:            d.Get("pod_cidr").(string),
 		ServiceCIDR:              d.Get("service_cidr").(string),
@@ -476,6 +710,10 @@ func buildKunernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.Kubernet
 		CloudMonitorFlags:        d.Get("install_cloud_monitor").(bool),
 		ZoneId:                   zoneId,
 	}
+	stackArgs.Network = getKubernetesNetworkPlugin(d)
+	stackArgs.NodeCIDRMask = getKubernetesNodeCIDRMask(d)
+	stackArgs.ProxyMode = d.Get("proxy_mode").(string)
+	stackArgs.UserData = getKubernetesUserData(d)
 
 	if v, ok := d.GetOk("vswitch_id"); ok && len(Trim(v.(string))) > 0 {
 		stackArgs.VSwitchID = Trim(v.(string))
@@ -492,12 +730,253 @@ func buildKunernetesArgs(d *schema.ResourceData, meta interface{}) (*cs.Kubernet
 		return nil, fmt.Errorf("The automatic created VPC and VSwitch must set 'new_nat_gateway' to 'true'.")
 	}
 
-	return &cs.KubernetesCreationArgs{
+	return &KubernetesCreationExtendedArgs{
 		Name:              clusterName,
-		ClusterType:       "Kubernetes",
+		ClusterType:       KubernetesClusterType,
 		DisableRollback:   true,
 		TimeoutMins:       60,
 		KubernetesVersion: stackArgs.KubernetesVersion,
 		StackParams:       *stackArgs,
+		Addons:            getKubernetesAddons(d),
 	}, nil
 }
+
+func buildKubernetesMultiAZArgs(d *schema.ResourceData, meta interface{}) (*KubernetesMultiAZCreationArgs, error) {
+	client := meta.(*AliyunClient)
+
+	_, validZones, err := client.DescribeAvailableResources(d, meta, InstanceTypeResource)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterName string
+	if v, ok := d.GetOk("name"); ok {
+		clusterName = v.(string)
+	} else {
+		clusterName = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
+	}
+
+	masterType := d.Get("master_instance_type").(string)
+	workerType := d.Get("worker_instance_type").(string)
+	numbers := splitKubernetesWorkerNumber(d.Get("worker_number").(int))
+
+	args := &KubernetesMultiAZCreationArgs{
+		ClusterType:              KubernetesClusterType,
+		Name:                     clusterName,
+		DisableRollback:          true,
+		TimeoutMins:              60,
+		MultiAZ:                  true,
+		KubernetesVersion:        getKubernetesVersion(d),
+		MasterInstanceTypeA:      masterType,
+		MasterInstanceTypeB:      masterType,
+		MasterInstanceTypeC:      masterType,
+		MasterSystemDiskCategory: ecs.DiskCategory(d.Get("master_disk_category").(string)),
+		MasterSystemDiskSize:     int64(d.Get("master_disk_size").(int)),
+		WorkerInstanceTypeA:      workerType,
+		WorkerInstanceTypeB:      workerType,
+		WorkerInstanceTypeC:      workerType,
+		WorkerSystemDiskCategory: ecs.DiskCategory(d.Get("worker_disk_category").(string)),
+		WorkerSystemDiskSize:     int64(d.Get("worker_disk_size").(int)),
+		NumOfNodesA:              numbers[0],
+		NumOfNodesB:              numbers[1],
+		NumOfNodesC:              numbers[2],
+		LoginPassword:            d.Get("password").(string),
+		ContainerCIDR:            d.Get("pod_cidr").(string),
+		ServiceCIDR:              d.Get("service_cidr").(string),
+		SSHFlags:                 d.Get("enable_ssh").(bool),
+		CloudMonitorFlags:        d.Get("install_cloud_monitor").(bool),
+		SNatEntry:                d.Get("new_nat_gateway").(bool),
+		Network:                  getKubernetesNetworkPlugin(d),
+		NodeCIDRMask:             getKubernetesNodeCIDRMask(d),
+		ProxyMode:                d.Get("proxy_mode").(string),
+		UserData:                 getKubernetesUserData(d),
+		Addons:                   getKubernetesAddons(d),
+	}
+
+	vswitchIds := expandStringList(d.Get("vswitch_ids").([]interface{}))
+	for i, id := range vswitchIds {
+		vsw, err := client.DescribeVswitch(Trim(id))
+		if err != nil {
+			return nil, err
+		}
+		if args.VPCID != "" && args.VPCID != vsw.VpcId {
+			return nil, fmt.Errorf("The specified vswitches %s must be in the same VPC.", strings.Join(vswitchIds, COMMA_SEPARATED))
+		}
+		args.VPCID = vsw.VpcId
+		vswitchIds[i] = vsw.VSwitchId
+
+		// Ensure instance_type is valid in each zone
+		if err := client.InstanceTypeValidation(masterType, vsw.ZoneId, validZones); err != nil {
+			return nil, err
+		}
+		if err := client.InstanceTypeValidation(workerType, vsw.ZoneId, validZones); err != nil {
+			return nil, err
+		}
+	}
+	args.VSwitchIdA = vswitchIds[0]
+	args.VSwitchIdB = vswitchIds[1]
+	args.VSwitchIdC = vswitchIds[2]
+
+	return args, nil
+}
+
+func buildKubernetesMultiAZScaleArgs(d *schema.ResourceData) *KubernetesMultiAZScaleArgs {
+	workerType := d.Get("worker_instance_type").(string)
+	numbers := splitKubernetesWorkerNumber(d.Get("worker_number").(int))
+
+	return &KubernetesMultiAZScaleArgs{
+		DisableRollback:     true,
+		TimeoutMins:         60,
+		LoginPassword:       d.Get("password").(string),
+		WorkerInstanceTypeA: workerType,
+		WorkerInstanceTypeB: workerType,
+		WorkerInstanceTypeC: workerType,
+		NumOfNodesA:         numbers[0],
+		NumOfNodesB:         numbers[1],
+		NumOfNodesC:         numbers[2],
+	}
+}
+
+func getKubernetesVersion(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("kubernetes_version"); ok {
+		return v.(string)
+	}
+	return KubernetesVersion
+}
+
+func getKubernetesNetworkPlugin(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("network_plugin"); ok {
+		return v.(string)
+	}
+	return KubernetesNetworkFlannel
+}
+
+func getKubernetesNodeCIDRMask(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("node_cidr_mask"); ok {
+		return strconv.Itoa(v.(int))
+	}
+	return ""
+}
+
+func getKubernetesUserData(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("user_data"); ok {
+		return base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+	return ""
+}
+
+func getKubernetesAddons(d *schema.ResourceData) (addons []KubernetesAddon) {
+	for _, v := range d.Get("addons").([]interface{}) {
+		addon := v.(map[string]interface{})
+		addons = append(addons, KubernetesAddon{
+			Name:   addon["name"].(string),
+			Config: addon["config"].(string),
+		})
+	}
+	return
+}
+
+func expandKubernetesAddons(list []interface{}) map[string]KubernetesAddon {
+	addons := make(map[string]KubernetesAddon)
+	for _, v := range list {
+		addon := v.(map[string]interface{})
+		addons[addon["name"].(string)] = KubernetesAddon{
+			Name:   addon["name"].(string),
+			Config: addon["config"].(string),
+		}
+	}
+	return addons
+}
+
+// flattenKubernetesAddons returns the configured addons in order with their installed config. The addons installed
+// by default, like the network plugin, are left out, and the uninstalled ones are dropped so that they show up in the diff.
+func flattenKubernetesAddons(configured []interface{}, installed []KubernetesAddon) (addons []map[string]interface{}) {
+	configs := make(map[string]string)
+	for _, addon := range installed {
+		configs[addon.Name] = addon.Config
+	}
+	for _, v := range configured {
+		name := v.(map[string]interface{})["name"].(string)
+		if config, ok := configs[name]; ok {
+			addons = append(addons, map[string]interface{}{
+				"name":   name,
+				"config": config,
+			})
+		}
+	}
+	return
+}
+
+// removeKubernetesWorkers selects the workers from the 'nodes' attribute, and then cordons, drains and releases them
+// through the cluster API instead of resizing the cluster, which removes the nodes arbitrarily.
+func removeKubernetesWorkers(d *schema.ResourceData, meta interface{}, config map[string]interface{}, count int) error {
+	client := meta.(*AliyunClient)
+
+	// Only the workers created along with the cluster can be removed and released. The nodes of node pools
+	// and the attached instances are managed by their own resources.
+	nodes, err := client.DescribeKubernetesClusterNodes(d.Id())
+	if err != nil {
+		return err
+	}
+	var workers []KubernetesClusterNode
+	for _, node := range nodes {
+		if isKubernetesClusterOwnWorker(node) {
+			workers = append(workers, node)
+		}
+	}
+
+	var instanceIds []string
+	switch selection := config["selection"].(string); selection {
+	case KubernetesNodeSelectionSpecific:
+		instanceIds = expandStringList(config["instance_ids"].([]interface{}))
+		if len(instanceIds) != count {
+			return fmt.Errorf("The number of 'instance_ids' in 'remove_nodes' must be %d, the number of the workers to remove.", count)
+		}
+		for _, id := range instanceIds {
+			found := false
+			for _, worker := range workers {
+				if worker.InstanceId == id {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("The instance %s in 'remove_nodes' is not a worker created along with the cluster %s.", id, d.Id())
+			}
+		}
+	default:
+		if count > len(workers) {
+			return fmt.Errorf("The cluster %s only has %d workers and %d of them can not be removed.", d.Id(), len(workers), count)
+		}
+		sort.Slice(workers, func(i, j int) bool {
+			if selection == KubernetesNodeSelectionOldest {
+				return workers[i].CreationTime < workers[j].CreationTime
+			}
+			return workers[i].CreationTime > workers[j].CreationTime
+		})
+		for _, worker := range workers[:count] {
+			instanceIds = append(instanceIds, worker.InstanceId)
+		}
+	}
+
+	var nodeNames []string
+	for _, id := range instanceIds {
+		node, err := client.DescribeKubernetesClusterNode(d.Id(), id)
+		if err != nil {
+			return err
+		}
+		nodeNames = append(nodeNames, node.NodeName)
+	}
+
+	if err := client.RemoveKubernetesClusterNodes(d.Id(), nodeNames, true, true); err != nil {
+		return fmt.Errorf("Removing nodes %s from Kubernetes Cluster got an error: %#v", strings.Join(instanceIds, COMMA_SEPARATED), err)
+	}
+
+	// The nodes are drained in parallel, so the drain timeout is the deadline of all of them. The API has no drain
+	// timeout, so it only limits how long to wait here.
+	if err := client.WaitForKubernetesClusterNodesRemoved(d.Id(), instanceIds, config["drain_timeout"].(int)); err != nil {
+		return err
+	}
+
+	return client.csconn.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+}
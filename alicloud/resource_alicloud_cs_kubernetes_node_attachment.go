@@ -0,0 +1,181 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCSKubernetesNodeAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSKubernetesNodeAttachmentCreate,
+		Read:   resourceAlicloudCSKubernetesNodeAttachmentRead,
+		Delete: resourceAlicloudCSKubernetesNodeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key_name"},
+			},
+			"key_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"format_disk": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"node_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSKubernetesNodeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	clusterId := d.Get("cluster_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	args := &ContainerClusterAttachArgs{
+		Password:   d.Get("password").(string),
+		KeyPair:    d.Get("key_name").(string),
+		Instances:  []string{instanceId},
+		FormatDisk: d.Get("format_disk").(bool),
+	}
+	if args.Password == "" && args.KeyPair == "" {
+		return fmt.Errorf("One of 'password' and 'key_name' must be specified to attach the instance %s.", instanceId)
+	}
+
+	if err := validateContainerClusterInstance(client, clusterId, instanceId); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		if err := client.ReimageInstance(instanceId, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.AttachContainerClusterNodes(clusterId, args); err != nil {
+		return fmt.Errorf("Attaching instance %s to Kubernetes Cluster %s got an error: %#v", instanceId, clusterId, err)
+	}
+
+	d.SetId(clusterId + COLON_SEPARATED + instanceId)
+
+	if err := client.WaitForKubernetesClusterNode(clusterId, instanceId, KubernetesNodeReady, DefaultLongTimeout); err != nil {
+		return fmt.Errorf("Waitting for kubernetes cluster node %s got an error: %#v", KubernetesNodeReady, err)
+	}
+
+	return resourceAlicloudCSKubernetesNodeAttachmentRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	node, err := client.DescribeKubernetesClusterNode(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_id", parts[0])
+	d.Set("instance_id", node.InstanceId)
+	d.Set("node_name", node.NodeName)
+	if len(node.IpAddress) > 0 {
+		d.Set("private_ip", node.IpAddress[0])
+	}
+	d.Set("status", node.NodeStatus)
+
+	return nil
+}
+
+func resourceAlicloudCSKubernetesNodeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	node, err := client.DescribeKubernetesClusterNode(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	// The instance is only removed from the cluster and it is still managed by its own resource.
+	if err := client.RemoveKubernetesClusterNodes(parts[0], []string{node.NodeName}, true, false); err != nil {
+		if IsExceptedError(err, ErrorClusterNotFound) {
+			return nil
+		}
+		return fmt.Errorf("Removing node %s from Kubernetes Cluster %s got an error: %#v", parts[1], parts[0], err)
+	}
+
+	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeKubernetesClusterNode(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Remove Kubernetes Cluster Node timeout."))
+	})
+}
+
+// validateContainerClusterInstance ensures the instance is in the VPC of the cluster before attaching it.
+func validateContainerClusterInstance(client *AliyunClient, clusterId, instanceId string) error {
+	cluster, err := client.csconn.DescribeCluster(clusterId)
+	if err != nil {
+		return fmt.Errorf("Describing Container Cluster %s got an error: %#v", clusterId, err)
+	}
+	instance, err := client.QueryInstancesById(instanceId)
+	if err != nil {
+		return fmt.Errorf("QueryInstanceById %s got an error: %#v.", instanceId, err)
+	}
+	if cluster.VPCID != "" && instance.VpcAttributes.VpcId != cluster.VPCID {
+		return fmt.Errorf("The instance %s must be in the VPC %s of the cluster %s.", instanceId, cluster.VPCID, clusterId)
+	}
+	return nil
+}
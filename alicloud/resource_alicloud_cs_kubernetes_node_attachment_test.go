@@ -0,0 +1,139 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCSKubernetesNodeAttachment_basic(t *testing.T) {
+	var node KubernetesClusterNode
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_kubernetes_node_attachment.attach",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesNodeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKubernetesNodeAttachment_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodeAttachmentExists("alicloud_cs_kubernetes_node_attachment.attach", &node),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_attachment.attach", "status", "Ready"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes_node_attachment.attach", "node_name"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes_node_attachment.attach", "private_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesNodeAttachmentExists(n string, node *KubernetesClusterNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No node attachment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		attr, err := client.DescribeKubernetesClusterNode(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*node = attr
+		return nil
+	}
+}
+
+func testAccCheckKubernetesNodeAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cs_kubernetes_node_attachment" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := client.DescribeKubernetesClusterNode(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error node %s still exists in the cluster.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccKubernetesNodeAttachment_basic = `
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+data "alicloud_images" main {
+  most_recent = true
+  name_regex = "^centos_7\\w{1,5}[64].*"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_node_attachment"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_managed_kubernetes" "k8s" {
+  name = "terraform-test-for-node-attachment"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway = true
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 2
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+}
+
+resource "alicloud_instance" "foo" {
+  image_id = "${data.alicloud_images.main.images.0.id}"
+  instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_groups = ["${alicloud_cs_managed_kubernetes.k8s.security_group_id}"]
+  instance_name = "tf-test-node-attachment"
+  password = "Test12345"
+}
+
+resource "alicloud_cs_kubernetes_node_attachment" "attach" {
+  cluster_id = "${alicloud_cs_managed_kubernetes.k8s.id}"
+  instance_id = "${alicloud_instance.foo.id}"
+  password = "Test12345"
+  format_disk = true
+}
+`
@@ -0,0 +1,482 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCSKubernetesNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSKubernetesNodePoolCreate,
+		Read:   resourceAlicloudCSKubernetesNodePoolRead,
+		Update: resourceAlicloudCSKubernetesNodePoolUpdate,
+		Delete: resourceAlicloudCSKubernetesNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateContainerName,
+			},
+			"vswitch_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateInstanceType,
+				},
+			},
+			"password": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key_name"},
+			},
+			"key_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"node_count": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateIntegerInRange(0, 1000),
+				ConflictsWith: []string{"scaling_config"},
+			},
+			"system_disk_category": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ecs.DiskCategoryCloudEfficiency,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(ecs.DiskCategoryCloudEfficiency), string(ecs.DiskCategoryCloudSSD)}),
+			},
+			"system_disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      40,
+				ValidateFunc: validateIntegerInRange(20, 500),
+			},
+			"data_disks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 16,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ecs.DiskCategoryCloudEfficiency,
+							ValidateFunc: validateAllowedStringValue([]string{
+								string(ecs.DiskCategoryCloudEfficiency), string(ecs.DiskCategoryCloudSSD)}),
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      40,
+							ValidateFunc: validateIntegerInRange(20, 32768),
+						},
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"taints": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  KubernetesTaintNoSchedule,
+							ValidateFunc: validateAllowedStringValue([]string{KubernetesTaintNoSchedule,
+								KubernetesTaintPreferNoSchedule, KubernetesTaintNoExecute}),
+						},
+					},
+				},
+			},
+			"spot_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecs.NoSpot,
+				ValidateFunc: validateInstanceSpotStrategy,
+			},
+			"spot_price_limit": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateInstanceType,
+						},
+						"price_limit": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+			"scaling_config": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"node_count"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 1000),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "cpu",
+							ValidateFunc: validateAllowedStringValue([]string{"cpu", "gpu", "gpushare", "spot"}),
+						},
+					},
+				},
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	clusterId := d.Get("cluster_id").(string)
+
+	pool, err := buildKubernetesNodePool(d)
+	if err != nil {
+		return fmt.Errorf("Creating Kubernetes Node Pool got an error: %#v", err)
+	}
+	if !pool.AutoScaling.Enable {
+		pool.Count = int64(d.Get("node_count").(int))
+	}
+
+	var resp struct {
+		NodePoolId string `json:"nodepool_id"`
+	}
+	if err := client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/nodepools", nil, pool, &resp); err != nil {
+		return fmt.Errorf("Creating Kubernetes Node Pool got an error: %#v", err)
+	}
+
+	d.SetId(clusterId + COLON_SEPARATED + resp.NodePoolId)
+
+	if err := client.WaitForKubernetesNodePool(clusterId, resp.NodePoolId, KubernetesNodePoolActive, DefaultLongTimeout); err != nil {
+		return fmt.Errorf("Waitting for node pool %#v got an error: %#v", KubernetesNodePoolActive, err)
+	}
+
+	return resourceAlicloudCSKubernetesNodePoolRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	pool, err := client.DescribeKubernetesNodePool(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_id", parts[0])
+	d.Set("name", pool.NodePoolInfo.Name)
+	d.Set("vswitch_ids", pool.ScalingGroup.VSwitchIds)
+	d.Set("instance_types", pool.ScalingGroup.InstanceTypes)
+	d.Set("key_name", pool.ScalingGroup.KeyPair)
+	d.Set("system_disk_category", pool.ScalingGroup.SystemDiskCategory)
+	d.Set("system_disk_size", pool.ScalingGroup.SystemDiskSize)
+	d.Set("scaling_group_id", pool.ScalingGroup.ScalingGroupId)
+	d.Set("security_group_id", pool.ScalingGroup.SecurityGroupId)
+	if pool.ScalingGroup.SpotStrategy != "" {
+		d.Set("spot_strategy", pool.ScalingGroup.SpotStrategy)
+	}
+	if pool.Status != nil {
+		d.Set("node_count", pool.Status.TotalNodes)
+	}
+
+	var disks []map[string]interface{}
+	for _, disk := range pool.ScalingGroup.DataDisks {
+		encrypted, _ := strconv.ParseBool(disk.Encrypted)
+		disks = append(disks, map[string]interface{}{
+			"category":  disk.Category,
+			"size":      disk.Size,
+			"encrypted": encrypted,
+		})
+	}
+	if err := d.Set("data_disks", disks); err != nil {
+		return fmt.Errorf("Setting data_disks got an error: %#v.", err)
+	}
+
+	var prices []map[string]interface{}
+	for _, price := range pool.ScalingGroup.SpotPriceLimit {
+		prices = append(prices, map[string]interface{}{
+			"instance_type": price.InstanceType,
+			"price_limit":   price.PriceLimit,
+		})
+	}
+	if err := d.Set("spot_price_limit", prices); err != nil {
+		return fmt.Errorf("Setting spot_price_limit got an error: %#v.", err)
+	}
+
+	var labels []map[string]interface{}
+	for _, label := range pool.KubernetesConfig.Labels {
+		labels = append(labels, map[string]interface{}{
+			"key":   label.Key,
+			"value": label.Value,
+		})
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return fmt.Errorf("Setting labels got an error: %#v.", err)
+	}
+
+	var taints []map[string]interface{}
+	for _, taint := range pool.KubernetesConfig.Taints {
+		taints = append(taints, map[string]interface{}{
+			"key":    taint.Key,
+			"value":  taint.Value,
+			"effect": taint.Effect,
+		})
+	}
+	if err := d.Set("taints", taints); err != nil {
+		return fmt.Errorf("Setting taints got an error: %#v.", err)
+	}
+
+	var scaling []map[string]interface{}
+	if pool.AutoScaling.Enable {
+		scaling = append(scaling, map[string]interface{}{
+			"min_size": pool.AutoScaling.MinInstances,
+			"max_size": pool.AutoScaling.MaxInstances,
+			"type":     pool.AutoScaling.Type,
+		})
+	}
+	if err := d.Set("scaling_config", scaling); err != nil {
+		return fmt.Errorf("Setting scaling_config got an error: %#v.", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudCSKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	d.Partial(true)
+
+	keys := []string{"name", "vswitch_ids", "instance_types", "password", "key_name", "system_disk_category",
+		"system_disk_size", "data_disks", "labels", "taints", "spot_strategy", "spot_price_limit", "scaling_config"}
+	update := false
+	for _, key := range keys {
+		if d.HasChange(key) {
+			update = true
+		}
+	}
+	if update {
+		pool, err := buildKubernetesNodePool(d)
+		if err != nil {
+			return fmt.Errorf("Updating Kubernetes Node Pool got an error: %#v", err)
+		}
+		if err := client.csconn.Invoke("", http.MethodPut, "/clusters/"+parts[0]+"/nodepools/"+parts[1], nil, pool, nil); err != nil {
+			return fmt.Errorf("Updating Kubernetes Node Pool got an error: %#v", err)
+		}
+		if err := client.WaitForKubernetesNodePool(parts[0], parts[1], KubernetesNodePoolActive, DefaultLongTimeout); err != nil {
+			return fmt.Errorf("Waitting for node pool %#v got an error: %#v", KubernetesNodePoolActive, err)
+		}
+		for _, key := range keys {
+			d.SetPartial(key)
+		}
+	}
+
+	// The nodes are managed by the autoscaler when the scaling config is set.
+	if _, ok := d.GetOk("scaling_config"); !ok && d.HasChange("node_count") {
+		o, n := d.GetChange("node_count")
+		if n.(int) > o.(int) {
+			if err := client.ScaleOutKubernetesNodePool(parts[0], parts[1], n.(int)-o.(int)); err != nil {
+				return fmt.Errorf("Scaling out Kubernetes Node Pool got an error: %#v", err)
+			}
+		} else {
+			if err := client.ScaleInKubernetesNodePool(parts[0], d.Get("scaling_group_id").(string), o.(int)-n.(int)); err != nil {
+				return fmt.Errorf("Scaling in Kubernetes Node Pool got an error: %#v", err)
+			}
+		}
+		if err := client.WaitForKubernetesNodePool(parts[0], parts[1], KubernetesNodePoolActive, DefaultLongTimeout); err != nil {
+			return fmt.Errorf("Waitting for node pool %#v got an error: %#v", KubernetesNodePoolActive, err)
+		}
+		d.SetPartial("node_count")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudCSKubernetesNodePoolRead(d, meta)
+}
+
+func resourceAlicloudCSKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+		if err := client.csconn.Invoke("", http.MethodDelete, "/clusters/"+parts[0]+"/nodepools/"+parts[1]+"?force=true", nil, nil, nil); err != nil {
+			if IsExceptedError(err, ErrorNodePoolNotFound) || IsExceptedError(err, ErrorClusterNotFound) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete Kubernetes Node Pool timeout and get an error: %#v.", err))
+		}
+
+		if _, err := client.DescribeKubernetesNodePool(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Delete Kubernetes Node Pool timeout."))
+	})
+}
+
+func buildKubernetesNodePool(d *schema.ResourceData) (*KubernetesNodePool, error) {
+	pool := &KubernetesNodePool{
+		NodePoolInfo: KubernetesNodePoolInfo{
+			Name: d.Get("name").(string),
+		},
+		ScalingGroup: KubernetesNodePoolScalingGroup{
+			VSwitchIds:         expandStringList(d.Get("vswitch_ids").([]interface{})),
+			InstanceTypes:      expandStringList(d.Get("instance_types").([]interface{})),
+			SystemDiskCategory: d.Get("system_disk_category").(string),
+			SystemDiskSize:     int64(d.Get("system_disk_size").(int)),
+			DataDisks:          []KubernetesNodePoolDisk{},
+			LoginPassword:      d.Get("password").(string),
+			KeyPair:            d.Get("key_name").(string),
+			SpotStrategy:       d.Get("spot_strategy").(string),
+		},
+		KubernetesConfig: KubernetesNodePoolKubernetesConfig{
+			Labels: []KubernetesNodePoolLabel{},
+			Taints: []KubernetesNodePoolTaint{},
+		},
+	}
+	if pool.ScalingGroup.LoginPassword == "" && pool.ScalingGroup.KeyPair == "" {
+		return nil, fmt.Errorf("One of 'password' and 'key_name' must be specified.")
+	}
+
+	for _, v := range d.Get("data_disks").([]interface{}) {
+		disk := v.(map[string]interface{})
+		pool.ScalingGroup.DataDisks = append(pool.ScalingGroup.DataDisks, KubernetesNodePoolDisk{
+			Category:  disk["category"].(string),
+			Size:      int64(disk["size"].(int)),
+			Encrypted: strconv.FormatBool(disk["encrypted"].(bool)),
+		})
+	}
+
+	prices := d.Get("spot_price_limit").([]interface{})
+	if len(prices) > 0 && pool.ScalingGroup.SpotStrategy != string(ecs.SpotWithPriceLimit) {
+		return nil, fmt.Errorf("'spot_price_limit' can only be set when 'spot_strategy' is %s.", ecs.SpotWithPriceLimit)
+	}
+	for _, v := range prices {
+		price := v.(map[string]interface{})
+		pool.ScalingGroup.SpotPriceLimit = append(pool.ScalingGroup.SpotPriceLimit, KubernetesNodePoolSpotPrice{
+			InstanceType: price["instance_type"].(string),
+			PriceLimit:   price["price_limit"].(float64),
+		})
+	}
+
+	for _, v := range d.Get("labels").([]interface{}) {
+		label := v.(map[string]interface{})
+		pool.KubernetesConfig.Labels = append(pool.KubernetesConfig.Labels, KubernetesNodePoolLabel{
+			Key:   label["key"].(string),
+			Value: label["value"].(string),
+		})
+	}
+
+	for _, v := range d.Get("taints").([]interface{}) {
+		taint := v.(map[string]interface{})
+		pool.KubernetesConfig.Taints = append(pool.KubernetesConfig.Taints, KubernetesNodePoolTaint{
+			Key:    taint["key"].(string),
+			Value:  taint["value"].(string),
+			Effect: taint["effect"].(string),
+		})
+	}
+
+	if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 {
+		scaling := v.([]interface{})[0].(map[string]interface{})
+		pool.AutoScaling = KubernetesNodePoolAutoScaling{
+			Enable:       true,
+			MinInstances: int64(scaling["min_size"].(int)),
+			MaxInstances: int64(scaling["max_size"].(int)),
+			Type:         scaling["type"].(string),
+		}
+		if pool.AutoScaling.MinInstances > pool.AutoScaling.MaxInstances {
+			return nil, fmt.Errorf("'min_size' must be less than or equal to 'max_size' in the scaling_config.")
+		}
+	}
+
+	return pool, nil
+}
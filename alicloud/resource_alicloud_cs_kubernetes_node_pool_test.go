@@ -0,0 +1,220 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCSKubernetesNodePool_basic(t *testing.T) {
+	var pool KubernetesNodePool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_kubernetes_node_pool.pool",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKubernetesNodePool_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.pool", &pool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "name", "tf-test-node-pool"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "node_count", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "data_disks.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "labels.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "taints.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "taints.0.effect", "NoSchedule"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes_node_pool.pool", "scaling_group_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKubernetesNodePool_scale,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.pool", &pool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCSKubernetesNodePool_autoScaling(t *testing.T) {
+	var pool KubernetesNodePool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_kubernetes_node_pool.pool",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesNodePoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKubernetesNodePool_autoScaling,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesNodePoolExists("alicloud_cs_kubernetes_node_pool.pool", &pool),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "instance_types.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "spot_strategy", "SpotAsPriceGo"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "scaling_config.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "scaling_config.0.min_size", "0"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "scaling_config.0.max_size", "3"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes_node_pool.pool", "scaling_config.0.type", "spot"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesNodePoolExists(n string, pool *KubernetesNodePool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No node pool ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		attr, err := client.DescribeKubernetesNodePool(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*pool = attr
+		return nil
+	}
+}
+
+func testAccCheckKubernetesNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cs_kubernetes_node_pool" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := client.DescribeKubernetesNodePool(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error node pool %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccKubernetesNodePool_cluster = `
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_node_pool"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_managed_kubernetes" "k8s" {
+  name = "terraform-test-for-node-pool"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway = true
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 2
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+}
+`
+
+const testAccKubernetesNodePool_basic = testAccKubernetesNodePool_cluster + `
+resource "alicloud_cs_kubernetes_node_pool" "pool" {
+  cluster_id = "${alicloud_cs_managed_kubernetes.k8s.id}"
+  name = "tf-test-node-pool"
+  vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  password = "Test12345"
+  node_count = 1
+  data_disks = [{
+    category = "cloud_ssd"
+    size = 100
+  }]
+  labels = [{
+    key = "workload"
+    value = "memory"
+  }]
+  taints = [{
+    key = "dedicated"
+    value = "memory"
+  }]
+}
+`
+
+const testAccKubernetesNodePool_scale = testAccKubernetesNodePool_cluster + `
+resource "alicloud_cs_kubernetes_node_pool" "pool" {
+  cluster_id = "${alicloud_cs_managed_kubernetes.k8s.id}"
+  name = "tf-test-node-pool"
+  vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}"]
+  password = "Test12345"
+  node_count = 2
+  data_disks = [{
+    category = "cloud_ssd"
+    size = 100
+  }]
+  labels = [{
+    key = "workload"
+    value = "memory"
+  }]
+  taints = [{
+    key = "dedicated"
+    value = "memory"
+  }]
+}
+`
+
+const testAccKubernetesNodePool_autoScaling = testAccKubernetesNodePool_cluster + `
+resource "alicloud_cs_kubernetes_node_pool" "pool" {
+  cluster_id = "${alicloud_cs_managed_kubernetes.k8s.id}"
+  name = "tf-test-node-pool-spot"
+  vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+  instance_types = ["${data.alicloud_instance_types.default.instance_types.0.id}", "${data.alicloud_instance_types.default.instance_types.1.id}"]
+  password = "Test12345"
+  spot_strategy = "SpotAsPriceGo"
+  scaling_config {
+    min_size = 0
+    max_size = 3
+    type = "spot"
+  }
+}
+`
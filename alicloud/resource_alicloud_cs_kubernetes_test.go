@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/denverdino/aliyungo/cs"
@@ -32,6 +33,10 @@ func TestAccAlicloudCSKubernetes_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "worker_disk_category", "cloud_efficiency"),
 					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "master_disk_size", "40"),
 					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "connections.%", "4"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes.k8s", "kube_config"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes.k8s", "client_cert"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes.k8s", "client_key"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes.k8s", "cluster_ca_cert"),
 				),
 			},
 		},
@@ -68,6 +73,112 @@ func TestAccAlicloudCSKubernetes_autoVpc(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudCSKubernetes_multiAZ(t *testing.T) {
+	var k8s cs.ClusterType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_kubernetes.k8s",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerKubernetes_multiAZ,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "name", "terraform-test-for-k8s-multi-az"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "vswitch_ids.#", "3"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "worker_number", "3"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "nodes.#", "6"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "connections.%", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCSKubernetes_network(t *testing.T) {
+	var k8s cs.ClusterType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_kubernetes.k8s",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerKubernetes_network("1.11.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "kubernetes_version", "1.11.5"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "network_plugin", "terway"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "node_cidr_mask", "25"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "proxy_mode", "ipvs"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "addons.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "addons.0.name", "nginx-ingress-controller"),
+				),
+			},
+			// The network attributes are read back, so importing does not replace the cluster.
+			resource.TestStep{
+				ResourceName:      "alicloud_cs_kubernetes.k8s",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"name_prefix", "new_nat_gateway", "pod_cidr",
+					"service_cidr", "enable_ssh", "password", "install_cloud_monitor", "addons"},
+			},
+			resource.TestStep{
+				Config: testAccContainerKubernetes_network("1.12.6-aliyun.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "kubernetes_version", "1.12.6-aliyun.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudCSKubernetes_removeNodes(t *testing.T) {
+	var k8s cs.ClusterType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_kubernetes.k8s",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerKubernetes_removeNodes(3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "worker_number", "3"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "nodes.#", "6"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_kubernetes.k8s", "nodes.0.creation_time"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContainerKubernetes_removeNodes(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "worker_number", "2"),
+					resource.TestCheckResourceAttr("alicloud_cs_kubernetes.k8s", "nodes.#", "5"),
+				),
+			},
+		},
+	})
+}
+
 const testAccContainerKubernetes_basic = `
 
 provider "alicloud" {
@@ -143,3 +254,140 @@ resource "alicloud_cs_kubernetes" "k8s" {
   master_disk_size = 50
 }
 `
+
+const testAccContainerKubernetes_multiAZ = `
+provider "alicloud" {
+	region="cn-hangzhou"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_k8s_multi_az"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  count = 3
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.${count.index + 1}.0/24"
+  availability_zone = "${lookup(data.alicloud_zones.main.zones[count.index], "id")}"
+}
+
+resource "alicloud_cs_kubernetes" "k8s" {
+  name = "terraform-test-for-k8s-multi-az"
+  vswitch_ids = ["${alicloud_vswitch.foo.*.id}"]
+  new_nat_gateway = true
+  master_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 3
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+}
+`
+
+func testAccContainerKubernetes_network(version string) string {
+	return fmt.Sprintf(`
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_k8s_network"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_kubernetes" "k8s" {
+  name = "terraform-test-for-k8s-network"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway = true
+  master_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 2
+  password = "Test12345"
+  pod_cidr = "192.168.0.0/16"
+  service_cidr = "172.21.0.0/20"
+  kubernetes_version = "%s"
+  network_plugin = "terway"
+  node_cidr_mask = 25
+  proxy_mode = "ipvs"
+  user_data = "echo hello"
+
+  addons {
+    name = "nginx-ingress-controller"
+    config = "{\"IngressSlbNetworkType\":\"internet\"}"
+  }
+}
+`, version)
+}
+
+func testAccContainerKubernetes_removeNodes(workerNumber int) string {
+	return fmt.Sprintf(`
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_k8s_remove_nodes"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_kubernetes" "k8s" {
+  name = "terraform-test-for-k8s-remove-nodes"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway = true
+  master_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = %d
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+
+  remove_nodes {
+    selection = "oldest"
+    drain_timeout = 900
+  }
+}
+`, workerNumber)
+}
@@ -0,0 +1,412 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/denverdino/aliyungo/cs"
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCSManagedKubernetes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSManagedKubernetesCreate,
+		Read:   resourceAlicloudCSManagedKubernetesRead,
+		Update: resourceAlicloudCSManagedKubernetesUpdate,
+		Delete: resourceAlicloudCSKubernetesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateContainerName,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "Terraform-Creation",
+				ValidateFunc:  validateContainerNamePrefix,
+				ConflictsWith: []string{"name"},
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"new_nat_gateway": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"worker_instance_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateInstanceType,
+			},
+			"worker_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"pod_cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"worker_disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      40,
+				ValidateFunc: validateIntegerInRange(20, 32768),
+			},
+			"worker_disk_category": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ecs.DiskCategoryCloudEfficiency,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(ecs.DiskCategoryCloudEfficiency), string(ecs.DiskCategoryCloudSSD)}),
+			},
+			"install_cloud_monitor": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_outdated": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"nodes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"connections": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_server_internet": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_server_intranet": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nat_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_cert": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_cert_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_ca_cert": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_cert_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args, err := buildManagedKubernetesArgs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := client.CreateContainerCluster(getRegion(d, meta), args)
+	if err != nil {
+		return fmt.Errorf("Creating Managed Kubernetes Cluster got an error: %#v", err)
+	}
+
+	d.SetId(cluster.ClusterID)
+
+	if err := client.csconn.WaitForClusterAsyn(cluster.ClusterID, cs.Running, 3600); err != nil {
+		return fmt.Errorf("Waitting for managed kubernetes cluster %#v got an error: %#v", cs.Running, err)
+	}
+
+	return resourceAlicloudCSManagedKubernetesUpdate(d, meta)
+}
+
+func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.csconn
+	d.Partial(true)
+	if d.HasChange("worker_number") && !d.IsNewResource() {
+		args := &ManagedKubernetesScaleArgs{
+			DisableRollback:          true,
+			TimeoutMins:              60,
+			WorkerInstanceType:       d.Get("worker_instance_type").(string),
+			WorkerSystemDiskCategory: ecs.DiskCategory(d.Get("worker_disk_category").(string)),
+			WorkerSystemDiskSize:     int64(d.Get("worker_disk_size").(int)),
+			NumOfNodes:               int64(d.Get("worker_number").(int)),
+			LoginPassword:            d.Get("password").(string),
+		}
+		if err := client.ResizeContainerCluster(d.Id(), args); err != nil {
+			return fmt.Errorf("Resize Cluster got an error: %#v", err)
+		}
+
+		if err := conn.WaitForClusterAsyn(d.Id(), cs.Running, 3600); err != nil {
+			return fmt.Errorf("Waitting for container Cluster %#v got an error: %#v", cs.Running, err)
+		}
+		d.SetPartial("worker_number")
+	}
+
+	if !d.IsNewResource() && (d.HasChange("name") || d.HasChange("name_prefix")) {
+		var clusterName string
+		if v, ok := d.GetOk("name"); ok {
+			clusterName = v.(string)
+		} else {
+			clusterName = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
+		}
+		if err := conn.ModifyClusterName(d.Id(), clusterName); err != nil && !IsExceptedError(err, ErrorClusterNameAlreadyExist) {
+			return fmt.Errorf("Modify Cluster Name got an error: %#v", err)
+		}
+		d.SetPartial("name")
+		d.SetPartial("name_prefix")
+	}
+	d.Partial(false)
+
+	return resourceAlicloudCSManagedKubernetesRead(d, meta)
+}
+
+func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	cluster, err := client.csconn.DescribeCluster(d.Id())
+
+	if err != nil {
+		if NotFoundError(err) || IsExceptedError(err, ErrorClusterNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", cluster.Name)
+	d.Set("vswitch_id", cluster.VSwitchID)
+	d.Set("vpc_id", cluster.VPCID)
+	d.Set("security_group_id", cluster.SecurityGroupID)
+
+	var nodes []map[string]interface{}
+	var worker KubernetesClusterNode
+	// The nodes of node pools and the attached instances are not managed by worker_number.
+	var otherNumber int64
+
+	result, err := client.DescribeKubernetesClusterNodes(d.Id())
+	if err != nil {
+		return err
+	}
+	for _, node := range result {
+		if node.InstanceRole == "Worker" && !isKubernetesClusterOwnWorker(node) {
+			otherNumber++
+		}
+		mapping := map[string]interface{}{
+			"id":         node.InstanceId,
+			"name":       node.InstanceName,
+			"private_ip": node.IpAddress[0],
+			"role":       node.InstanceRole,
+		}
+		nodes = append(nodes, mapping)
+		if worker.InstanceId == "" && node.InstanceRole == "Worker" {
+			worker = node
+		}
+	}
+	d.Set("nodes", nodes)
+	// There is no master node in the managed kubernetes cluster
+	d.Set("worker_number", cluster.Size-otherNumber)
+
+	// The worker attributes can not be worked out when there is no worker node, like all of them have been removed.
+	if worker.InstanceId != "" {
+		d.Set("worker_instance_type", worker.InstanceType)
+		if disks, _, err := client.ecsconn.DescribeDisks(&ecs.DescribeDisksArgs{
+			RegionId:   getRegion(d, meta),
+			InstanceId: worker.InstanceId,
+			DiskType:   ecs.DiskTypeAllSystem,
+		}); err != nil {
+			return fmt.Errorf("[ERROR] DescribeDisks By Id %s: %#v.", worker.InstanceId, err)
+		} else if len(disks) > 0 {
+			d.Set("worker_disk_size", disks[0].Size)
+			d.Set("worker_disk_category", disks[0].Category)
+			d.Set("availability_zone", disks[0].ZoneId)
+		}
+
+		if cluster.SecurityGroupID == "" {
+			if inst, err := client.QueryInstancesById(worker.InstanceId); err != nil {
+				return fmt.Errorf("[ERROR] QueryInstanceById %s got an error: %#v.", worker.InstanceId, err)
+			} else if len(inst.SecurityGroupIds.SecurityGroupId) > 0 {
+				d.Set("security_group_id", inst.SecurityGroupIds.SecurityGroupId[0])
+			}
+		}
+	}
+
+	// The API server of managed kubernetes cluster is not on any node, and its endpoints come from the master url.
+	endpoints, err := parseKubernetesMasterURL(cluster.MasterURL)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Parsing master url %s got an error: %#v.", cluster.MasterURL, err)
+	}
+	connection := make(map[string]string)
+	connection["api_server_internet"] = endpoints.ApiServerEndpoint
+	connection["api_server_intranet"] = endpoints.IntranetApiServerEndpoint
+	connection["service_domain"] = fmt.Sprintf("*.%s.%s.alicontainer.com", d.Id(), cluster.RegionID)
+	d.Set("connections", connection)
+
+	req := vpc.CreateDescribeNatGatewaysRequest()
+	req.VpcId = cluster.VPCID
+	if nat, err := client.vpcconn.DescribeNatGateways(req); err != nil {
+		return fmt.Errorf("[ERROR] DescribeNatGateways by VPC Id %s: %#v.", cluster.VPCID, err)
+	} else if nat != nil && len(nat.NatGateways.NatGateway) > 0 {
+		d.Set("nat_gateway_id", nat.NatGateways.NatGateway[0].NatGatewayId)
+	}
+
+	// The credentials are not essential to the cluster, so failing to get them does not break the refreshing.
+	creds, err := client.DescribeKubernetesClusterCredentials(d.Id())
+	if err != nil {
+		log.Printf("[WARN] DescribeKubernetesClusterCredentials %s got an error: %#v.", d.Id(), err)
+		return nil
+	}
+
+	return setKubernetesClusterCredentials(d, creds, false)
+}
+
+func buildManagedKubernetesArgs(d *schema.ResourceData, meta interface{}) (*ManagedKubernetesCreationArgs, error) {
+	client := meta.(*AliyunClient)
+
+	// Ensure instance_type is valid. The outdated instance types are available when 'is_outdated' is true.
+	zoneId, validZones, err := client.DescribeAvailableResources(d, meta, InstanceTypeResource)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.InstanceTypeValidation(d.Get("worker_instance_type").(string), zoneId, validZones); err != nil {
+		return nil, err
+	}
+
+	var clusterName string
+	if v, ok := d.GetOk("name"); ok {
+		clusterName = v.(string)
+	} else {
+		clusterName = resource.PrefixedUniqueId(d.Get("name_prefix").(string))
+	}
+
+	args := &ManagedKubernetesCreationArgs{
+		ClusterType:              ManagedKubernetesClusterType,
+		Name:                     clusterName,
+		DisableRollback:          true,
+		TimeoutMins:              60,
+		KubernetesVersion:        KubernetesVersion,
+		RegionId:                 getRegionId(d, meta),
+		ZoneId:                   zoneId,
+		WorkerInstanceType:       d.Get("worker_instance_type").(string),
+		WorkerSystemDiskCategory: ecs.DiskCategory(d.Get("worker_disk_category").(string)),
+		WorkerSystemDiskSize:     int64(d.Get("worker_disk_size").(int)),
+		NumOfNodes:               int64(d.Get("worker_number").(int)),
+		LoginPassword:            d.Get("password").(string),
+		ContainerCIDR:            d.Get("pod_cidr").(string),
+		ServiceCIDR:              d.Get("service_cidr").(string),
+		CloudMonitorFlags:        d.Get("install_cloud_monitor").(bool),
+		SNatEntry:                d.Get("new_nat_gateway").(bool),
+	}
+
+	if v, ok := d.GetOk("vswitch_id"); ok && len(Trim(v.(string))) > 0 {
+		args.VSwitchId = Trim(v.(string))
+		vsw, err := client.DescribeVswitch(args.VSwitchId)
+		if err != nil {
+			return nil, err
+		}
+		if args.ZoneId != "" && args.ZoneId != vsw.ZoneId {
+			return nil, fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, args.ZoneId)
+		}
+		args.VPCID = vsw.VpcId
+		args.ZoneId = vsw.ZoneId
+	} else if !args.SNatEntry {
+		return nil, fmt.Errorf("The automatic created VPC and VSwitch must set 'new_nat_gateway' to 'true'.")
+	}
+
+	return args, nil
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/denverdino/aliyungo/cs"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCSManagedKubernetes_basic(t *testing.T) {
+	var k8s cs.ClusterType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_managed_kubernetes.k8s",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerManagedKubernetes_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_managed_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "name", "terraform-test-for-managed-k8s"),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "worker_number", "2"),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "nodes.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "worker_disk_size", "50"),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "worker_disk_category", "cloud_efficiency"),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "connections.%", "3"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_managed_kubernetes.k8s", "kube_config"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContainerManagedKubernetes_scale,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerClusterExists("alicloud_cs_managed_kubernetes.k8s", &k8s),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "worker_number", "3"),
+					resource.TestCheckResourceAttr("alicloud_cs_managed_kubernetes.k8s", "nodes.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccContainerManagedKubernetes_basic = `
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_managed_k8s"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_managed_kubernetes" "k8s" {
+  name = "terraform-test-for-managed-k8s"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway = true
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 2
+  worker_disk_size = 50
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+}
+`
+
+const testAccContainerManagedKubernetes_scale = `
+provider "alicloud" {
+	region="cn-shanghai"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+	availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+	cpu_core_count = 2
+	memory_size = 4
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_managed_k8s"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_managed_kubernetes" "k8s" {
+  name = "terraform-test-for-managed-k8s"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  new_nat_gateway = true
+  worker_instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  worker_number = 3
+  worker_disk_size = 50
+  password = "Test12345"
+  pod_cidr = "192.168.1.0/24"
+  service_cidr = "192.168.2.0/24"
+}
+`
@@ -0,0 +1,146 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudCSSwarmNodeAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudCSSwarmNodeAttachmentCreate,
+		Read:   resourceAlicloudCSSwarmNodeAttachmentRead,
+		Delete: resourceAlicloudCSSwarmNodeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"format_disk": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"private_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudCSSwarmNodeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	clusterId := d.Get("cluster_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	if err := validateContainerClusterInstance(client, clusterId, instanceId); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		if err := client.ReimageInstance(instanceId, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	args := &ContainerClusterAttachArgs{
+		Password:   d.Get("password").(string),
+		Instances:  []string{instanceId},
+		FormatDisk: d.Get("format_disk").(bool),
+	}
+	if err := client.AttachContainerClusterNodes(clusterId, args); err != nil {
+		return fmt.Errorf("Attaching instance %s to Swarm Cluster %s got an error: %#v", instanceId, clusterId, err)
+	}
+
+	d.SetId(clusterId + COLON_SEPARATED + instanceId)
+
+	if err := client.WaitForSwarmClusterNode(clusterId, instanceId, SwarmNodeRunning, DefaultLongTimeout); err != nil {
+		return fmt.Errorf("Waitting for swarm cluster node %s got an error: %#v", SwarmNodeRunning, err)
+	}
+
+	return resourceAlicloudCSSwarmNodeAttachmentRead(d, meta)
+}
+
+func resourceAlicloudCSSwarmNodeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	node, err := client.DescribeSwarmClusterNode(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cluster_id", parts[0])
+	d.Set("instance_id", node.InstanceId)
+	d.Set("private_ip", node.Ip)
+	d.Set("status", node.Status)
+
+	return nil
+}
+
+func resourceAlicloudCSSwarmNodeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parts := strings.Split(d.Id(), COLON_SEPARATED)
+
+	node, err := client.DescribeSwarmClusterNode(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	// The instance is only removed from the cluster and it is still managed by its own resource.
+	if err := client.RemoveSwarmClusterNode(parts[0], node.Ip); err != nil {
+		if IsExceptedError(err, ErrorClusterNotFound) {
+			return nil
+		}
+		return fmt.Errorf("Removing node %s from Swarm Cluster %s got an error: %#v", parts[1], parts[0], err)
+	}
+
+	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+		if _, err := client.DescribeSwarmClusterNode(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Remove Swarm Cluster Node timeout."))
+	})
+}
@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudCSSwarmNodeAttachment_basic(t *testing.T) {
+	var node SwarmClusterNode
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "alicloud_cs_swarm_node_attachment.attach",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSwarmNodeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSwarmNodeAttachment_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSwarmNodeAttachmentExists("alicloud_cs_swarm_node_attachment.attach", &node),
+					resource.TestCheckResourceAttr("alicloud_cs_swarm_node_attachment.attach", "status", "running"),
+					resource.TestCheckResourceAttrSet("alicloud_cs_swarm_node_attachment.attach", "private_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSwarmNodeAttachmentExists(n string, node *SwarmClusterNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No node attachment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		attr, err := client.DescribeSwarmClusterNode(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*node = attr
+		return nil
+	}
+}
+
+func testAccCheckSwarmNodeAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_cs_swarm_node_attachment" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, COLON_SEPARATED)
+		if _, err := client.DescribeSwarmClusterNode(parts[0], parts[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error node %s still exists in the cluster.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccSwarmNodeAttachment_basic = `
+data "alicloud_images" main {
+  most_recent = true
+  name_regex = "^centos_6\\w{1,5}[64].*"
+}
+
+data "alicloud_zones" main {
+  available_resource_creation = "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  name = "tf_test_swarm_node_attachment"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "alicloud_vswitch" "foo" {
+  vpc_id = "${alicloud_vpc.foo.id}"
+  cidr_block = "10.1.1.0/24"
+  availability_zone = "${data.alicloud_zones.main.zones.0.id}"
+}
+
+resource "alicloud_cs_swarm" "cs_vpc" {
+  password = "Just$test"
+  instance_type = "ecs.n4.small"
+  name = "tf-test-swarm-node-attachment"
+  node_number = 1
+  disk_category = "cloud_efficiency"
+  disk_size = 20
+  cidr_block = "172.20.0.0/24"
+  image_id = "${data.alicloud_images.main.images.0.id}"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+
+resource "alicloud_security_group" "foo" {
+  name = "tf_test_swarm_node_attachment"
+  vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_instance" "foo" {
+  image_id = "${data.alicloud_images.main.images.0.id}"
+  instance_type = "ecs.n4.small"
+  vswitch_id = "${alicloud_vswitch.foo.id}"
+  security_groups = ["${alicloud_security_group.foo.id}"]
+  instance_name = "tf-test-swarm-node-attachment"
+  password = "Just$test"
+}
+
+resource "alicloud_cs_swarm_node_attachment" "attach" {
+  cluster_id = "${alicloud_cs_swarm.cs_vpc.id}"
+  instance_id = "${alicloud_instance.foo.id}"
+  password = "Just$test"
+  image_id = "${data.alicloud_images.main.images.0.id}"
+}
+`
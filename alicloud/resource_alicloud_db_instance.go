@@ -102,11 +102,89 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"parameters": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+
+			"restore": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"restore_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateDBTime(DBRestoreTimeLayout),
+						},
+					},
+				},
+			},
+
 			"port": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"tde_status": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{TDEEnabled, TDEDisabled}),
+				Optional:     true,
+				Computed:     true,
+			},
+
+			"ssl_action": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{SSLActionOpen, SSLActionClose, SSLActionUpdate}),
+				Optional:     true,
+				Computed:     true,
+			},
+
+			"ssl_connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"sql_collector_status": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{SQLCollectorEnabled, SQLCollectorDisabled}),
+				Optional:     true,
+				Computed:     true,
+			},
+
+			"sql_collector_config_value": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validateAllowedIntValue(SQL_COLLECTOR_RETENTION),
+				Optional:     true,
+				Default:      30,
+			},
+
 			"db_instance_net_type": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -480,24 +558,46 @@ func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{})
 	client := meta.(*AliyunClient)
 	conn := client.rdsconn
 
-	request, err := buildDBCreateRequest(d, meta)
-	if err != nil {
-		return err
-	}
+	if _, ok := d.GetOk("restore"); ok {
+		request, err := buildDBCloneRequest(d, meta)
+		if err != nil {
+			return err
+		}
 
-	resp, err := conn.CreateDBInstance(request)
+		resp, err := conn.CloneDBInstance(request)
+		if err != nil {
+			return fmt.Errorf("Error cloning Alicloud db instance from %s: %#v", request.DBInstanceId, err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("Error creating Alicloud db instance: %#v", err)
-	}
+		d.SetId(resp.DBInstanceId)
+	} else {
+		request, err := buildDBCreateRequest(d, meta)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.CreateDBInstance(request)
 
-	d.SetId(resp.DBInstanceId)
+		if err != nil {
+			return fmt.Errorf("Error creating Alicloud db instance: %#v", err)
+		}
+
+		d.SetId(resp.DBInstanceId)
+	}
 
 	// wait instance status change from Creating to running
 	if err := client.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
 		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
 	}
 
+	// the cloned instance inherits the whitelist of the source instance
+	if _, ok := d.GetOk("restore"); ok && len(d.Get("security_ips").(*schema.Set).List()) > 0 {
+		ipstr := strings.Join(expandStringList(d.Get("security_ips").(*schema.Set).List())[:], COMMA_SEPARATED)
+		if err := client.ModifyDBSecurityIps(d.Id(), ipstr); err != nil {
+			return fmt.Errorf("Modify DB security ips %s got an error: %#v", ipstr, err)
+		}
+	}
+
 	return resourceAlicloudDBInstanceUpdate(d, meta)
 }
 
@@ -506,6 +606,13 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 	conn := client.rdsconn
 	d.Partial(true)
 
+	if d.HasChange("parameters") {
+		if err := client.ModifyParameters(d, "parameters"); err != nil {
+			return err
+		}
+		d.SetPartial("parameters")
+	}
+
 	if d.HasChange("security_ips") && !d.IsNewResource() {
 		ipList := expandStringList(d.Get("security_ips").(*schema.Set).List())
 
@@ -562,10 +669,91 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if err := modifyDBInstanceSecurity(d, meta); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 	return resourceAlicloudDBInstanceRead(d, meta)
 }
 
+// modifyDBInstanceSecurity applies the TDE, SSL and SQL collector settings.
+func modifyDBInstanceSecurity(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.rdsconn
+
+	if v, ok := d.GetOk("tde_status"); ok && d.HasChange("tde_status") {
+		if v.(string) == TDEDisabled && !d.IsNewResource() {
+			return fmt.Errorf("TDE can not be disabled after it has been enabled.")
+		}
+		if v.(string) == TDEEnabled {
+			request := rds.CreateModifyDBInstanceTDERequest()
+			request.DBInstanceId = d.Id()
+			request.TDEStatus = TDEEnabled
+
+			if _, err := conn.ModifyDBInstanceTDE(request); err != nil {
+				return fmt.Errorf("ModifyDBInstanceTDE got an error: %#v", err)
+			}
+			// enabling TDE will restart the instance
+			if err := client.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+				return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+			}
+		}
+		d.SetPartial("tde_status")
+	}
+
+	if v, ok := d.GetOk("ssl_action"); ok && (d.HasChange("ssl_action") || d.HasChange("ssl_connection_string")) {
+		action := v.(string)
+		if !(d.IsNewResource() && action == SSLActionClose) {
+			request := rds.CreateModifyDBInstanceSSLRequest()
+			request.DBInstanceId = d.Id()
+			request.ConnectionString = d.Get("ssl_connection_string").(string)
+			if request.ConnectionString == "" {
+				instance, err := client.DescribeDBInstanceById(d.Id())
+				if err != nil {
+					return fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+				}
+				request.ConnectionString = instance.ConnectionString
+			}
+			// The vendored SDK does not support the parameter SSLEnabled yet.
+			if action == SSLActionClose {
+				request.QueryParams["SSLEnabled"] = "0"
+			} else {
+				request.QueryParams["SSLEnabled"] = "1"
+			}
+
+			if _, err := conn.ModifyDBInstanceSSL(request); err != nil {
+				return fmt.Errorf("ModifyDBInstanceSSL got an error: %#v", err)
+			}
+			// modifying SSL will restart the instance
+			if err := client.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+				return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+			}
+		}
+		d.SetPartial("ssl_action")
+		d.SetPartial("ssl_connection_string")
+	}
+
+	if v, ok := d.GetOk("sql_collector_status"); ok && (d.HasChange("sql_collector_status") || d.HasChange("sql_collector_config_value")) {
+		request := rds.CreateModifySQLCollectorPolicyRequest()
+		request.DBInstanceId = d.Id()
+		// The API uses 'Enable' instead of 'Enabled'.
+		request.SQLCollectorStatus = "Enable"
+		if v.(string) == SQLCollectorDisabled {
+			request.SQLCollectorStatus = SQLCollectorDisabled
+		}
+		request.StoragePeriod = requests.NewInteger(d.Get("sql_collector_config_value").(int))
+
+		if _, err := conn.ModifySQLCollectorPolicy(request); err != nil {
+			return fmt.Errorf("ModifySQLCollectorPolicy got an error: %#v", err)
+		}
+		d.SetPartial("sql_collector_status")
+		d.SetPartial("sql_collector_config_value")
+	}
+
+	return nil
+}
+
 func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
@@ -585,6 +773,14 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("security_ips", ips)
 
+	if err := client.RefreshParameters(d, "parameters"); err != nil {
+		return err
+	}
+
+	if err := refreshDBInstanceSecurity(d, meta); err != nil {
+		return err
+	}
+
 	d.Set("engine", instance.Engine)
 	d.Set("engine_version", instance.EngineVersion)
 	d.Set("instance_type", instance.DBInstanceClass)
@@ -671,6 +867,54 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// refreshDBInstanceSecurity reads back the TDE, SSL and SQL collector settings. A setting is skipped
+// when the engine of the instance does not support it.
+func refreshDBInstanceSecurity(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	tde, err := client.DescribeDBInstanceTDE(d.Id())
+	if err != nil && !IsExceptedErrors(err, DBInstanceSecurityNotSupported) {
+		return fmt.Errorf("DescribeDBInstanceTDE got an error: %#v", err)
+	}
+	if err == nil {
+		d.Set("tde_status", tde.TDEStatus)
+	}
+
+	ssl, err := client.DescribeDBInstanceSSL(d.Id())
+	if err != nil && !IsExceptedErrors(err, DBInstanceSecurityNotSupported) {
+		return fmt.Errorf("DescribeDBInstanceSSL got an error: %#v", err)
+	}
+	if err == nil {
+		action := SSLActionClose
+		if ssl.ConnectionString != "" {
+			action = SSLActionOpen
+			// 'Update' is an action of renewing certificate and it keeps the SSL open.
+			if d.Get("ssl_action").(string) == SSLActionUpdate {
+				action = SSLActionUpdate
+			}
+			d.Set("ssl_connection_string", ssl.ConnectionString)
+		}
+		d.Set("ssl_action", action)
+	}
+
+	policy, err := client.DescribeSQLCollectorPolicy(d.Id())
+	if err != nil && !IsExceptedErrors(err, DBInstanceSecurityNotSupported) {
+		return fmt.Errorf("DescribeSQLCollectorPolicy got an error: %#v", err)
+	}
+	if err == nil {
+		status := SQLCollectorDisabled
+		if policy.SQLCollectorStatus == "Enable" || policy.SQLCollectorStatus == SQLCollectorEnabled {
+			status = SQLCollectorEnabled
+		}
+		d.Set("sql_collector_status", status)
+		if policy.StoragePeriod > 0 {
+			d.Set("sql_collector_config_value", policy.StoragePeriod)
+		}
+	}
+
+	return nil
+}
+
 func resourceAlicloudDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
@@ -786,3 +1030,67 @@ func buildDBCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.Create
 
 	return request, nil
 }
+
+// buildDBCloneRequest builds a request to clone a new instance from the backup set or the point in time of the source instance.
+func buildDBCloneRequest(d *schema.ResourceData, meta interface{}) (*rds.CloneDBInstanceRequest, error) {
+	client := meta.(*AliyunClient)
+	restore := d.Get("restore").([]interface{})[0].(map[string]interface{})
+
+	request := rds.CreateCloneDBInstanceRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.DBInstanceId = Trim(restore["source_instance_id"].(string))
+
+	backupId := Trim(restore["backup_id"].(string))
+	restoreTime := Trim(restore["restore_time"].(string))
+	if (backupId == "") == (restoreTime == "") {
+		return nil, fmt.Errorf("One and only one of 'backup_id' and 'restore_time' must be specified in 'restore'.")
+	}
+	if backupId != "" {
+		request.BackupId = backupId
+	} else {
+		request.RestoreTime = restoreTime
+	}
+
+	source, err := client.DescribeDBInstanceById(request.DBInstanceId)
+	if err != nil {
+		return nil, fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+	}
+	if source.Engine != d.Get("engine").(string) || source.EngineVersion != d.Get("engine_version").(string) {
+		return nil, fmt.Errorf("The engine %s %s must be the same as the source instance %s, which is %s %s.",
+			d.Get("engine").(string), d.Get("engine_version").(string), source.DBInstanceId, source.Engine, source.EngineVersion)
+	}
+
+	request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
+	request.DBInstanceClass = Trim(d.Get("instance_type").(string))
+	request.DBInstanceDescription = d.Get("instance_name").(string)
+	request.InstanceNetworkType = string(Classic)
+
+	if vswitchId := Trim(d.Get("vswitch_id").(string)); vswitchId != "" {
+		vsw, err := client.DescribeVswitch(vswitchId)
+		if err != nil {
+			return nil, fmt.Errorf("DescribeVSwitche got an error: %#v.", err)
+		}
+		request.VSwitchId = vswitchId
+		request.VPCId = vsw.VpcId
+		request.InstanceNetworkType = strings.ToUpper(string(Vpc))
+	}
+
+	request.PayType = Trim(d.Get("instance_charge_type").(string))
+	if PayType(request.PayType) == Prepaid {
+		period := d.Get("period").(int)
+		request.UsedTime = strconv.Itoa(period)
+		request.Period = string(Month)
+		if period > 9 {
+			request.UsedTime = strconv.Itoa(period / 12)
+			request.Period = string(Year)
+		}
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		uuid = resource.UniqueId()
+	}
+	request.ClientToken = fmt.Sprintf("Terraform-Alicloud-%d-%s", time.Now().Unix(), uuid)
+
+	return request, nil
+}
@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/denverdino/aliyungo/common"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -190,6 +192,161 @@ func TestAccAlicloudDBInstance_upgradeClass(t *testing.T) {
 
 }
 
+func TestAccAlicloudDBInstance_parameters(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBInstance_parameters,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "parameters.#", "1"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccDBInstance_parametersUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "parameters.#", "2"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccAlicloudDBInstance_security(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBInstance_security,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_status", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_config_value", "30"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "tde_status", "Disabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "ssl_action", "Close"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccDBInstance_securityUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "tde_status", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "ssl_action", "Open"),
+					resource.TestCheckResourceAttrSet("alicloud_db_instance.foo", "ssl_connection_string"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.foo", "sql_collector_config_value", "180"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccAlicloudDBInstance_restore(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.clone",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBInstance_restore,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.clone", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.clone", "restore.#", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_db_instance.clone", "restore.0.backup_id"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.clone", "instance_name", "tf_testAccDBInstance_clone"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestBuildDBCloneRequest_restoreSource(t *testing.T) {
+	client := &AliyunClient{Region: common.Beijing}
+	for _, restore := range []map[string]interface{}{
+		{"source_instance_id": "rm-test"},
+		{"source_instance_id": "rm-test", "backup_id": "123456", "restore_time": "2018-06-01T08:30:00Z"},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceAlicloudDBInstance().Schema, map[string]interface{}{
+			"engine":           "MySQL",
+			"engine_version":   "5.6",
+			"instance_type":    "rds.mysql.s1.small",
+			"instance_storage": 10,
+			"restore":          []interface{}{restore},
+		})
+		if _, err := buildDBCloneRequest(d, client); err == nil {
+			t.Fatalf("building the clone request from %#v should fail.", restore)
+		}
+	}
+}
+
+func TestAccAlicloudDBInstance_restoreTime(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_instance.clone",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBInstance_restoreTime,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_instance.clone", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_instance.clone", "restore.#", "1"),
+					resource.TestCheckResourceAttrSet("alicloud_db_instance.clone", "restore.0.restore_time"),
+					resource.TestCheckResourceAttr("alicloud_db_instance.clone", "instance_name", "tf_testAccDBInstance_clone"),
+				),
+			},
+		},
+	})
+
+}
+
 func testAccCheckSecurityIpExists(n string, ips []map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -386,3 +543,123 @@ resource "alicloud_db_instance" "foo" {
 	instance_storage = "10"
 }
 `
+
+const testAccDBInstance_parameters = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.t1.small"
+	instance_storage = "10"
+	instance_charge_type = "Postpaid"
+	parameters = [{
+		name = "innodb_large_prefix"
+		value = "ON"
+	}]
+}
+`
+
+const testAccDBInstance_parametersUpdate = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.t1.small"
+	instance_storage = "10"
+	instance_charge_type = "Postpaid"
+	parameters = [{
+		name = "innodb_large_prefix"
+		value = "ON"
+	},{
+		name = "connect_timeout"
+		value = "50"
+	}]
+}
+`
+
+const testAccDBInstance_security = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s2.large"
+	instance_storage = "20"
+	instance_charge_type = "Postpaid"
+	sql_collector_status = "Enabled"
+}
+`
+
+const testAccDBInstance_securityUpdate = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s2.large"
+	instance_storage = "20"
+	instance_charge_type = "Postpaid"
+	tde_status = "Enabled"
+	ssl_action = "Open"
+	sql_collector_status = "Enabled"
+	sql_collector_config_value = 180
+}
+`
+
+const testAccDBInstance_restore = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "10"
+	instance_charge_type = "Postpaid"
+}
+
+resource "alicloud_db_backup_policy" "foo" {
+	instance_id = "${alicloud_db_instance.foo.id}"
+}
+
+data "alicloud_db_backups" "foo" {
+	instance_id = "${alicloud_db_backup_policy.foo.instance_id}"
+	backup_status = "Success"
+}
+
+resource "alicloud_db_instance" "clone" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "10"
+	instance_charge_type = "Postpaid"
+	instance_name = "tf_testAccDBInstance_clone"
+	restore {
+		source_instance_id = "${alicloud_db_instance.foo.id}"
+		backup_id = "${data.alicloud_db_backups.foo.backups.0.id}"
+	}
+}
+`
+
+const testAccDBInstance_restoreTime = `
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "10"
+	instance_charge_type = "Postpaid"
+}
+
+resource "alicloud_db_backup_policy" "foo" {
+	instance_id = "${alicloud_db_instance.foo.id}"
+}
+
+data "alicloud_db_backups" "foo" {
+	instance_id = "${alicloud_db_backup_policy.foo.instance_id}"
+	backup_status = "Success"
+}
+
+resource "alicloud_db_instance" "clone" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "10"
+	instance_charge_type = "Postpaid"
+	instance_name = "tf_testAccDBInstance_clone"
+	restore {
+		source_instance_id = "${alicloud_db_instance.foo.id}"
+		restore_time = "${data.alicloud_db_backups.foo.backups.0.backup_end_time}"
+	}
+}
+`
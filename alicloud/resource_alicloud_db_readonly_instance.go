@@ -0,0 +1,311 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudDBReadonlyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudDBReadonlyInstanceCreate,
+		Read:   resourceAlicloudDBReadonlyInstanceRead,
+		Update: resourceAlicloudDBReadonlyInstanceUpdate,
+		Delete: resourceAlicloudDBReadonlyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"engine_version": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateAllowedStringValue([]string{"5.5", "5.6", "5.7", "2008r2", "2012", "9.4", "9.3"}),
+				ForceNew:     true,
+				Required:     true,
+			},
+			"engine": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_storage": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"instance_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDBInstanceName,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"parameters": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"connection_string": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudDBReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request, err := buildDBReadonlyCreateRequest(d, meta)
+	if err != nil {
+		return err
+	}
+
+	// wait master instance status is running before creating
+	if err := client.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	var resp *rds.CreateReadOnlyDBInstanceResponse
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		r, err := client.rdsconn.CreateReadOnlyDBInstance(request)
+		if err != nil {
+			if IsExceptedError(err, OperationDeniedDBInstanceStatus) {
+				return resource.RetryableError(fmt.Errorf("Create readonly DB instance got an error: %#v.", err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Create readonly DB instance got an error: %#v.", err))
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resp.DBInstanceId)
+
+	// wait instance status change from Creating to running
+	if err := client.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	return resourceAlicloudDBReadonlyInstanceUpdate(d, meta)
+}
+
+func resourceAlicloudDBReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	conn := client.rdsconn
+	d.Partial(true)
+
+	if d.HasChange("parameters") {
+		if err := client.ModifyParameters(d, "parameters"); err != nil {
+			return err
+		}
+		d.SetPartial("parameters")
+	}
+
+	if d.HasChange("instance_name") {
+		request := rds.CreateModifyDBInstanceDescriptionRequest()
+		request.DBInstanceId = d.Id()
+		request.DBInstanceDescription = d.Get("instance_name").(string)
+
+		if _, err := conn.ModifyDBInstanceDescription(request); err != nil {
+			return fmt.Errorf("ModifyDBInstanceDescription got an error: %#v", err)
+		}
+		d.SetPartial("instance_name")
+	}
+
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlicloudDBReadonlyInstanceRead(d, meta)
+	}
+
+	update := false
+	request := rds.CreateModifyDBInstanceSpecRequest()
+	request.DBInstanceId = d.Id()
+	request.PayType = string(Postpaid)
+
+	if d.HasChange("instance_type") {
+		request.DBInstanceClass = d.Get("instance_type").(string)
+		update = true
+		d.SetPartial("instance_type")
+	}
+
+	if d.HasChange("instance_storage") {
+		request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
+		update = true
+		d.SetPartial("instance_storage")
+	}
+
+	if update {
+		// wait instance status is running before modifying
+		if err := client.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+		if _, err := conn.ModifyDBInstanceSpec(request); err != nil {
+			return err
+		}
+		// wait instance status is running after modifying
+		if err := client.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlicloudDBReadonlyInstanceRead(d, meta)
+}
+
+func resourceAlicloudDBReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	instance, err := client.DescribeDBInstanceById(d.Id())
+	if err != nil {
+		if NotFoundDBInstance(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+	}
+
+	if instance.DBInstanceType != string(ReadonlyDBInstance) {
+		return fmt.Errorf("DB instance %s is not a readonly instance and its type is %s.", d.Id(), instance.DBInstanceType)
+	}
+
+	if err := client.RefreshParameters(d, "parameters"); err != nil {
+		return err
+	}
+
+	d.Set("instance_id", instance.MasterInstanceId)
+	d.Set("engine", instance.Engine)
+	d.Set("engine_version", instance.EngineVersion)
+	d.Set("instance_type", instance.DBInstanceClass)
+	d.Set("instance_storage", instance.DBInstanceStorage)
+	d.Set("instance_name", instance.DBInstanceDescription)
+	d.Set("zone_id", instance.ZoneId)
+	d.Set("vswitch_id", instance.VSwitchId)
+	d.Set("connection_string", instance.ConnectionString)
+	d.Set("port", instance.Port)
+
+	return nil
+}
+
+func resourceAlicloudDBReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := rds.CreateDeleteDBInstanceRequest()
+	request.DBInstanceId = d.Id()
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := client.rdsconn.DeleteDBInstance(request)
+
+		if err != nil {
+			if NotFoundDBInstance(err) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete readonly DB instance timeout and got an error: %#v.", err))
+		}
+
+		instance, err := client.DescribeDBInstanceById(d.Id())
+		if err != nil {
+			if NotFoundDBInstance(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err))
+		}
+		if instance == nil {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete readonly DB instance timeout and got an error: %#v.", err))
+	})
+}
+
+func buildDBReadonlyCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.CreateReadOnlyDBInstanceRequest, error) {
+	client := meta.(*AliyunClient)
+	request := rds.CreateCreateReadOnlyDBInstanceRequest()
+	request.RegionId = string(getRegion(d, meta))
+	request.DBInstanceId = Trim(d.Get("instance_id").(string))
+	request.EngineVersion = Trim(d.Get("engine_version").(string))
+	request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
+	request.DBInstanceClass = Trim(d.Get("instance_type").(string))
+	request.DBInstanceDescription = d.Get("instance_name").(string)
+	// At present, readonly instance only supports postpaid.
+	request.PayType = string(Postpaid)
+
+	if zone, ok := d.GetOk("zone_id"); ok && Trim(zone.(string)) != "" {
+		request.ZoneId = Trim(zone.(string))
+	}
+
+	request.InstanceNetworkType = string(Classic)
+
+	if vswitchId := Trim(d.Get("vswitch_id").(string)); vswitchId != "" {
+		request.VSwitchId = vswitchId
+		request.InstanceNetworkType = strings.ToUpper(string(Vpc))
+
+		vsw, err := client.DescribeVswitch(vswitchId)
+		if err != nil {
+			return nil, fmt.Errorf("DescribeVSwitche got an error: %#v.", err)
+		}
+
+		if request.ZoneId == "" {
+			request.ZoneId = vsw.ZoneId
+		} else if request.ZoneId != vsw.ZoneId {
+			return nil, fmt.Errorf("The specified vswitch %s isn't in the zone %s.", vsw.VSwitchId, request.ZoneId)
+		}
+
+		request.VPCId = vsw.VpcId
+	}
+
+	if request.ZoneId == "" {
+		master, err := client.DescribeDBInstanceById(request.DBInstanceId)
+		if err != nil {
+			return nil, fmt.Errorf("Error Describe DB InstanceAttribute: %#v", err)
+		}
+		request.ZoneId = master.ZoneId
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		uuid = resource.UniqueId()
+	}
+	request.ClientToken = fmt.Sprintf("Terraform-Alicloud-%d-%s", time.Now().Unix(), uuid)
+
+	return request, nil
+}
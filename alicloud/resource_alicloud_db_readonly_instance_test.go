@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudDBReadonlyInstance_vpc(t *testing.T) {
+	var instance rds.DBInstanceAttribute
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_db_readonly_instance.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDBReadonlyInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDBReadonlyInstance_vpc,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_readonly_instance.foo", &instance),
+					resource.TestCheckResourceAttrPair("alicloud_db_readonly_instance.foo", "instance_id",
+						"alicloud_db_instance.foo", "id"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "engine", "MySQL"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_storage", "20"),
+					resource.TestCheckResourceAttrSet("alicloud_db_readonly_instance.foo", "connection_string"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDBReadonlyInstance_vpcUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(
+						"alicloud_db_readonly_instance.foo", &instance),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_storage", "30"),
+					resource.TestCheckResourceAttr("alicloud_db_readonly_instance.foo", "instance_name", "tf-testacc-readonly-update"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccCheckDBReadonlyInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_db_readonly_instance" && rs.Type != "alicloud_db_instance" {
+			continue
+		}
+
+		ins, err := client.DescribeDBInstanceById(rs.Primary.ID)
+
+		if ins != nil {
+			return fmt.Errorf("Error DB Instance %s still exist", rs.Primary.ID)
+		}
+
+		// Verify the error is what we want
+		if err != nil {
+			if NotFoundDBInstance(err) {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+const testAccDBReadonlyInstance_vpcBase = `
+data "alicloud_zones" "default" {
+	available_resource_creation = "Rds"
+}
+
+resource "alicloud_vpc" "foo" {
+	name = "tf_test_foo"
+	cidr_block = "172.16.0.0/12"
+}
+
+resource "alicloud_vswitch" "foo" {
+ 	vpc_id = "${alicloud_vpc.foo.id}"
+ 	cidr_block = "172.16.0.0/21"
+ 	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_db_instance" "foo" {
+	engine = "MySQL"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "20"
+	instance_charge_type = "Postpaid"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
+
+const testAccDBReadonlyInstance_vpc = testAccDBReadonlyInstance_vpcBase + `
+resource "alicloud_db_readonly_instance" "foo" {
+	instance_id = "${alicloud_db_instance.foo.id}"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "20"
+	instance_name = "tf-testacc-readonly"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
+
+const testAccDBReadonlyInstance_vpcUpdate = testAccDBReadonlyInstance_vpcBase + `
+resource "alicloud_db_readonly_instance" "foo" {
+	instance_id = "${alicloud_db_instance.foo.id}"
+	engine_version = "5.6"
+	instance_type = "rds.mysql.s1.small"
+	instance_storage = "30"
+	instance_name = "tf-testacc-readonly-update"
+	vswitch_id = "${alicloud_vswitch.foo.id}"
+}
+`
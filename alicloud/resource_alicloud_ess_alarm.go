@@ -0,0 +1,253 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEssAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunEssAlarmCreate,
+		Read:   resourceAliyunEssAlarmRead,
+		Update: resourceAliyunEssAlarmUpdate,
+		Delete: resourceAliyunEssAlarmDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alarm_actions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				MinItems: 1,
+				MaxItems: 5,
+			},
+			"metric_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      EssSystemMetric,
+				ValidateFunc: validateAllowedStringValue([]string{EssSystemMetric, EssCustomMetric}),
+			},
+			"metric_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      300,
+				ValidateFunc: validateAllowedIntValue([]int{60, 120, 300, 900}),
+			},
+			"statistics": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  Average,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(Average), string(Minimum), string(Maximum),
+				}),
+			},
+			"threshold": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateFloatString,
+				DiffSuppressFunc: floatStringDiffSuppressFunc,
+			},
+			"comparison_operator": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  MoreThanOrEqual,
+				ValidateFunc: validateAllowedStringValue([]string{
+					MoreThan, MoreThanOrEqual, LessThan, LessThanOrEqual,
+				}),
+			},
+			"evaluation_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
+			"dimensions": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+			"enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunEssAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := buildAlicloudEssAlarmArgs(d, meta)
+	args.ScalingGroupId = d.Get("scaling_group_id").(string)
+	args.MetricType = d.Get("metric_type").(string)
+	args.Period = d.Get("period").(int)
+
+	alarmId, err := client.CreateEssAlarm(args)
+	if err != nil {
+		return fmt.Errorf("CreateAlarm got an error: %#v", err)
+	}
+
+	d.SetId(alarmId)
+
+	if !d.Get("enable").(bool) {
+		if err := client.SetEssAlarmEnabled(d.Id(), false); err != nil {
+			return fmt.Errorf("DisableAlarm %s got an error: %#v", d.Id(), err)
+		}
+	}
+
+	return resourceAliyunEssAlarmRead(d, meta)
+}
+
+func resourceAliyunEssAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	alarm, err := client.DescribeEssAlarmById(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe ESS alarm Attribute: %#v", err)
+	}
+
+	d.Set("name", alarm.Name)
+	d.Set("description", alarm.Description)
+	d.Set("scaling_group_id", alarm.ScalingGroupId)
+	d.Set("alarm_actions", alarm.AlarmActions.AlarmAction)
+	d.Set("metric_type", alarm.MetricType)
+	d.Set("metric_name", alarm.MetricName)
+	d.Set("period", alarm.Period)
+	d.Set("statistics", alarm.Statistics)
+	d.Set("threshold", strconv.FormatFloat(alarm.Threshold, 'f', -1, 64))
+	d.Set("comparison_operator", alarm.ComparisonOperator)
+	d.Set("evaluation_count", alarm.EvaluationCount)
+	d.Set("enable", alarm.Enable)
+	d.Set("state", alarm.State)
+
+	dims := make(map[string]interface{})
+	for _, dim := range alarm.Dimensions.Dimension {
+		// The scaling group dimension is appended by ESS for system metrics automatically.
+		if dim.DimensionKey == "scaling_group" && dim.DimensionValue == alarm.ScalingGroupId {
+			continue
+		}
+		dims[dim.DimensionKey] = dim.DimensionValue
+	}
+	if err := d.Set("dimensions", dims); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAliyunEssAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	d.Partial(true)
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("alarm_actions") ||
+		d.HasChange("metric_name") || d.HasChange("statistics") || d.HasChange("threshold") ||
+		d.HasChange("comparison_operator") || d.HasChange("evaluation_count") || d.HasChange("dimensions") {
+		args := buildAlicloudEssAlarmArgs(d, meta)
+		args.AlarmTaskId = d.Id()
+		if err := client.ModifyEssAlarm(args); err != nil {
+			return fmt.Errorf("ModifyAlarm %s got an error: %#v", d.Id(), err)
+		}
+		d.SetPartial("name")
+		d.SetPartial("description")
+		d.SetPartial("alarm_actions")
+		d.SetPartial("metric_name")
+		d.SetPartial("statistics")
+		d.SetPartial("threshold")
+		d.SetPartial("comparison_operator")
+		d.SetPartial("evaluation_count")
+		d.SetPartial("dimensions")
+	}
+
+	if d.HasChange("enable") {
+		if err := client.SetEssAlarmEnabled(d.Id(), d.Get("enable").(bool)); err != nil {
+			return fmt.Errorf("Setting alarm %s enable to %t got an error: %#v", d.Id(), d.Get("enable").(bool), err)
+		}
+		d.SetPartial("enable")
+	}
+	d.Partial(false)
+
+	return resourceAliyunEssAlarmRead(d, meta)
+}
+
+func resourceAliyunEssAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := client.DeleteEssAlarmById(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete alarm timeout and got an error:%#v.", err))
+		}
+
+		if _, err := client.DescribeEssAlarmById(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete alarm timeout."))
+	})
+}
+
+func buildAlicloudEssAlarmArgs(d *schema.ResourceData, meta interface{}) *EssAlarmArgs {
+	args := &EssAlarmArgs{
+		RegionId:           getRegion(d, meta),
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		MetricName:         d.Get("metric_name").(string),
+		Statistics:         d.Get("statistics").(string),
+		Threshold:          d.Get("threshold").(string),
+		ComparisonOperator: d.Get("comparison_operator").(string),
+		EvaluationCount:    d.Get("evaluation_count").(int),
+	}
+
+	for _, v := range d.Get("alarm_actions").(*schema.Set).List() {
+		args.AlarmAction = append(args.AlarmAction, v.(string))
+	}
+
+	for k, v := range d.Get("dimensions").(map[string]interface{}) {
+		args.Dimension = append(args.Dimension, EssAlarmDimension{
+			DimensionKey:   k,
+			DimensionValue: v.(string),
+		})
+	}
+
+	return args
+}
@@ -0,0 +1,141 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEssAlarm_basic(t *testing.T) {
+	var alarm EssAlarm
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_alarm.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssAlarmDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssAlarmConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssAlarmExists("alicloud_ess_alarm.foo", &alarm),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "name", "tf-test-ess-alarm"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "metric_type", "system"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "metric_name", "CpuUtilization"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "period", "300"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "threshold", "80"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "comparison_operator", ">="),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "alarm_actions.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "enable", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssAlarmConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssAlarmExists("alicloud_ess_alarm.foo", &alarm),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "threshold", "90.5"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "evaluation_count", "2"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "statistics", "Maximum"),
+					resource.TestCheckResourceAttr("alicloud_ess_alarm.foo", "enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssAlarmExists(n string, d *EssAlarm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ESS Alarm ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeEssAlarmById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = attr
+		return nil
+	}
+}
+
+func testAccCheckEssAlarmDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ess_alarm" {
+			continue
+		}
+		if _, err := client.DescribeEssAlarmById(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error ESS alarm %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccEssAlarmConfig = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "tf-test-ess-alarm"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	adjustment_type = "QuantityChangeInCapacity"
+	adjustment_value = 1
+}
+
+resource "alicloud_ess_alarm" "foo" {
+	name = "tf-test-ess-alarm"
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	alarm_actions = ["${alicloud_ess_scaling_rule.foo.ari}"]
+	metric_name = "CpuUtilization"
+	threshold = "80"
+}
+`
+
+const testAccEssAlarmConfig_update = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "tf-test-ess-alarm"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	adjustment_type = "QuantityChangeInCapacity"
+	adjustment_value = 1
+}
+
+resource "alicloud_ess_alarm" "foo" {
+	name = "tf-test-ess-alarm"
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	alarm_actions = ["${alicloud_ess_scaling_rule.foo.ari}"]
+	metric_name = "CpuUtilization"
+	statistics = "Maximum"
+	threshold = "90.5"
+	evaluation_count = 2
+	enable = false
+}
+`
@@ -10,7 +10,7 @@ import (
 )
 
 func TestAccAlicloudEssAttachment_basic(t *testing.T) {
-	var sg ess.ScalingGroupItemType
+	var sg EssScalingGroup
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -37,7 +37,7 @@ func TestAccAlicloudEssAttachment_basic(t *testing.T) {
 	})
 }
 
-func testAccCheckEssAttachmentExists(n string, d *ess.ScalingGroupItemType) resource.TestCheckFunc {
+func testAccCheckEssAttachmentExists(n string, d *EssScalingGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
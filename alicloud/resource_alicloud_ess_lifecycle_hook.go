@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEssLifecycleHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunEssLifecycleHookCreate,
+		Read:   resourceAliyunEssLifecycleHookRead,
+		Update: resourceAliyunEssLifecycleHookUpdate,
+		Delete: resourceAliyunEssLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"lifecycle_transition": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(ScaleOutTransition), string(ScaleInTransition)}),
+			},
+			"heartbeat_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validateIntegerInRange(30, 21600),
+			},
+			"default_result": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ContinueLifecycleAction,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(ContinueLifecycleAction), string(AbandonLifecycleAction)}),
+			},
+			"notification_arn": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEssNotificationArn,
+			},
+			"notification_metadata": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunEssLifecycleHookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := &EssLifecycleHookArgs{
+		RegionId:             getRegion(d, meta),
+		ScalingGroupId:       d.Get("scaling_group_id").(string),
+		LifecycleHookName:    d.Get("name").(string),
+		LifecycleTransition:  LifecycleTransition(d.Get("lifecycle_transition").(string)),
+		HeartbeatTimeout:     d.Get("heartbeat_timeout").(int),
+		DefaultResult:        LifecycleActionResult(d.Get("default_result").(string)),
+		NotificationArn:      d.Get("notification_arn").(string),
+		NotificationMetadata: d.Get("notification_metadata").(string),
+	}
+
+	hookId, err := client.CreateEssLifecycleHook(args)
+	if err != nil {
+		return fmt.Errorf("CreateLifecycleHook got an error: %#v", err)
+	}
+
+	d.SetId(hookId)
+
+	return resourceAliyunEssLifecycleHookRead(d, meta)
+}
+
+func resourceAliyunEssLifecycleHookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	hook, err := client.DescribeEssLifecycleHookById(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe ESS lifecycle hook Attribute: %#v", err)
+	}
+
+	d.Set("scaling_group_id", hook.ScalingGroupId)
+	d.Set("name", hook.LifecycleHookName)
+	d.Set("lifecycle_transition", hook.LifecycleTransition)
+	d.Set("heartbeat_timeout", hook.HeartbeatTimeout)
+	d.Set("default_result", hook.DefaultResult)
+	d.Set("notification_arn", hook.NotificationArn)
+	d.Set("notification_metadata", hook.NotificationMetadata)
+
+	return nil
+}
+
+func resourceAliyunEssLifecycleHookUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := &EssLifecycleHookArgs{
+		RegionId:        getRegion(d, meta),
+		LifecycleHookId: d.Id(),
+	}
+
+	if d.HasChange("name") {
+		args.LifecycleHookName = d.Get("name").(string)
+	}
+
+	if d.HasChange("lifecycle_transition") {
+		args.LifecycleTransition = LifecycleTransition(d.Get("lifecycle_transition").(string))
+	}
+
+	if d.HasChange("heartbeat_timeout") {
+		args.HeartbeatTimeout = d.Get("heartbeat_timeout").(int)
+	}
+
+	if d.HasChange("default_result") {
+		args.DefaultResult = LifecycleActionResult(d.Get("default_result").(string))
+	}
+
+	if d.HasChange("notification_arn") {
+		args.NotificationArn = d.Get("notification_arn").(string)
+	}
+
+	if d.HasChange("notification_metadata") {
+		args.NotificationMetadata = d.Get("notification_metadata").(string)
+	}
+
+	if err := client.ModifyEssLifecycleHook(args); err != nil {
+		return fmt.Errorf("ModifyLifecycleHook got an error: %#v", err)
+	}
+
+	return resourceAliyunEssLifecycleHookRead(d, meta)
+}
+
+func resourceAliyunEssLifecycleHookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := client.DeleteEssLifecycleHookById(d.Id()); err != nil {
+			if IsExceptedError(err, InvalidLifecycleHookIdNotExist) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete lifecycle hook timeout and got an error:%#v.", err))
+		}
+
+		if _, err := client.DescribeEssLifecycleHookById(d.Id()); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete lifecycle hook timeout."))
+	})
+}
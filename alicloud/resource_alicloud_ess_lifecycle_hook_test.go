@@ -0,0 +1,123 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEssLifecycleHook_basic(t *testing.T) {
+	var hook EssLifecycleHook
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_lifecycle_hook.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssLifecycleHookDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssLifecycleHookConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssLifecycleHookExists("alicloud_ess_lifecycle_hook.foo", &hook),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "name", "tf-test-lifecycle-hook"),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "lifecycle_transition", "SCALE_OUT"),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "heartbeat_timeout", "400"),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "default_result", "CONTINUE"),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "notification_metadata", "bootstrap"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssLifecycleHookConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssLifecycleHookExists("alicloud_ess_lifecycle_hook.foo", &hook),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "lifecycle_transition", "SCALE_IN"),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "heartbeat_timeout", "600"),
+					resource.TestCheckResourceAttr("alicloud_ess_lifecycle_hook.foo", "default_result", "ABANDON"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssLifecycleHookExists(n string, d *EssLifecycleHook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ESS Lifecycle Hook ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		attr, err := client.DescribeEssLifecycleHookById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*d = attr
+		return nil
+	}
+}
+
+func testAccCheckEssLifecycleHookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ess_lifecycle_hook" {
+			continue
+		}
+		if _, err := client.DescribeEssLifecycleHookById(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error ESS lifecycle hook %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccEssLifecycleHookConfig = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 1
+	scaling_group_name = "tf-test-lifecycle-hook"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_lifecycle_hook" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	name = "tf-test-lifecycle-hook"
+	lifecycle_transition = "SCALE_OUT"
+	heartbeat_timeout = 400
+	notification_metadata = "bootstrap"
+}
+`
+
+const testAccEssLifecycleHookConfig_update = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 1
+	scaling_group_name = "tf-test-lifecycle-hook"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_lifecycle_hook" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	name = "tf-test-lifecycle-hook"
+	lifecycle_transition = "SCALE_IN"
+	heartbeat_timeout = 600
+	default_result = "ABANDON"
+	notification_metadata = "bootstrap"
+}
+`
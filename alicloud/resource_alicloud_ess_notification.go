@@ -0,0 +1,129 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudEssNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunEssNotificationCreate,
+		Read:   resourceAliyunEssNotificationRead,
+		Update: resourceAliyunEssNotificationUpdate,
+		Delete: resourceAliyunEssNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notification_arn": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEssNotificationArn,
+			},
+			"notification_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateAllowedStringValue([]string{
+						ScaleOutSuccessNotification, ScaleOutErrorNotification, ScaleInSuccessNotification, ScaleInErrorNotification,
+						ScaleRejectNotification, ScaleOutStartNotification, ScaleInStartNotification, ScheduleTaskExpiringNotification}),
+				},
+			},
+		},
+	}
+}
+
+func resourceAliyunEssNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	args := &EssNotificationArgs{
+		RegionId:         getRegion(d, meta),
+		ScalingGroupId:   d.Get("scaling_group_id").(string),
+		NotificationArn:  d.Get("notification_arn").(string),
+		NotificationType: expandStringList(d.Get("notification_types").(*schema.Set).List()),
+	}
+
+	if err := client.CreateEssNotification(args); err != nil {
+		return fmt.Errorf("CreateNotificationConfiguration got an error: %#v", err)
+	}
+
+	// The notification ARN contains colons, so the scaling group ID is put in front of it.
+	d.SetId(args.ScalingGroupId + COLON_SEPARATED + args.NotificationArn)
+
+	return resourceAliyunEssNotificationRead(d, meta)
+}
+
+func resourceAliyunEssNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	ids := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+
+	notification, err := client.DescribeEssNotification(ids[0], ids[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error Describe ESS notification Attribute: %#v", err)
+	}
+
+	d.Set("scaling_group_id", ids[0])
+	d.Set("notification_arn", notification.NotificationArn)
+	d.Set("notification_types", notification.NotificationTypes.NotificationType)
+
+	return nil
+}
+
+func resourceAliyunEssNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	ids := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+
+	if d.HasChange("notification_types") {
+		args := &EssNotificationArgs{
+			RegionId:         getRegion(d, meta),
+			ScalingGroupId:   ids[0],
+			NotificationArn:  ids[1],
+			NotificationType: expandStringList(d.Get("notification_types").(*schema.Set).List()),
+		}
+		if err := client.ModifyEssNotification(args); err != nil {
+			return fmt.Errorf("ModifyNotificationConfiguration got an error: %#v", err)
+		}
+	}
+
+	return resourceAliyunEssNotificationRead(d, meta)
+}
+
+func resourceAliyunEssNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	ids := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := client.DeleteEssNotification(ids[0], ids[1]); err != nil {
+			if IsExceptedError(err, NotificationConfigurationNotExist) || IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+				return nil
+			}
+			return resource.RetryableError(fmt.Errorf("Delete notification timeout and got an error:%#v.", err))
+		}
+
+		if _, err := client.DescribeEssNotification(ids[0], ids[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("Delete notification timeout."))
+	})
+}
@@ -0,0 +1,103 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudEssNotification_basic(t *testing.T) {
+	var notification EssNotification
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithAccountId(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_notification.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssNotificationConfig(`"AUTOSCALING:SCALE_OUT_SUCCESS"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssNotificationExists("alicloud_ess_notification.foo", &notification),
+					resource.TestCheckResourceAttr("alicloud_ess_notification.foo", "notification_types.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssNotificationConfig(`"AUTOSCALING:SCALE_OUT_SUCCESS", "AUTOSCALING:SCALE_IN_SUCCESS"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssNotificationExists("alicloud_ess_notification.foo", &notification),
+					resource.TestCheckResourceAttr("alicloud_ess_notification.foo", "notification_types.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssNotificationExists(n string, d *EssNotification) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ESS Notification ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		ids := strings.SplitN(rs.Primary.ID, COLON_SEPARATED, 2)
+		attr, err := client.DescribeEssNotification(ids[0], ids[1])
+		if err != nil {
+			return err
+		}
+
+		*d = attr
+		return nil
+	}
+}
+
+func testAccCheckEssNotificationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_ess_notification" {
+			continue
+		}
+		ids := strings.SplitN(rs.Primary.ID, COLON_SEPARATED, 2)
+		if _, err := client.DescribeEssNotification(ids[0], ids[1]); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Error ESS notification %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEssNotificationConfig(types string) string {
+	return fmt.Sprintf(`
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 1
+	scaling_group_name = "tf-test-notification"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_notification" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	notification_arn = "acs:ess:%s:%s:cloudmonitor"
+	notification_types = [%s]
+}
+`, os.Getenv("ALICLOUD_REGION"), os.Getenv("ALICLOUD_ACCOUNT_ID"), types)
+}
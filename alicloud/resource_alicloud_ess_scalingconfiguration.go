@@ -40,10 +40,47 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 				Required: true,
 			},
 			"instance_type": &schema.Schema{
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateInstanceType,
+				ConflictsWith: []string{"instance_types"},
+			},
+			"instance_types": &schema.Schema{
+				Type:          schema.TypeList,
+				ForceNew:      true,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				MaxItems:      10,
+				MinItems:      1,
+				ConflictsWith: []string{"instance_type"},
+			},
+			"spot_strategy": &schema.Schema{
 				Type:         schema.TypeString,
+				Optional:     true,
 				ForceNew:     true,
-				Required:     true,
-				ValidateFunc: validateInstanceType,
+				Default:      ecs.NoSpot,
+				ValidateFunc: validateInstanceSpotStrategy,
+			},
+			"spot_price_limit": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateInstanceType,
+						},
+						"price_limit": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
 			},
 			"io_optimized": &schema.Schema{
 				Type:       schema.TypeString,
@@ -175,29 +212,34 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 
 func resourceAliyunEssScalingConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 
-	// Ensure instance_type is generation three
-	zoneId, validZones, err := meta.(*AliyunClient).DescribeAvailableResources(d, meta, InstanceTypeResource)
+	args, err := buildAlicloudEssScalingConfigurationArgs(d, meta)
 	if err != nil {
 		return err
 	}
-	if err := meta.(*AliyunClient).InstanceTypeValidation(d.Get("instance_type").(string), zoneId, validZones); err != nil {
-		return err
-	}
 
-	args, err := buildAlicloudEssScalingConfigurationArgs(d, meta)
+	// Ensure instance_type is generation three
+	zoneId, validZones, err := meta.(*AliyunClient).DescribeAvailableResources(d, meta, InstanceTypeResource)
 	if err != nil {
 		return err
 	}
+	for _, instanceType := range append([]string{args.InstanceType}, args.InstanceTypes...) {
+		if instanceType == "" {
+			continue
+		}
+		if err := meta.(*AliyunClient).InstanceTypeValidation(instanceType, zoneId, validZones); err != nil {
+			return err
+		}
+	}
 
 	args.IoOptimized = ecs.IoOptimizedOptimized
 	if d.Get("is_outdated").(bool) == true {
 		args.IoOptimized = ecs.IoOptimizedNone
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	client := meta.(*AliyunClient)
 
 	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		scaling, err := essconn.CreateScalingConfiguration(args)
+		scaling, err := client.CreateEssScalingConfiguration(args)
 		if err != nil {
 			if IsExceptedError(err, EssThrottling) || IsExceptedError(err, IncorrectScalingGroupStatus) {
 				return resource.RetryableError(fmt.Errorf("Error Create Scaling Configuration: %#v.", err))
@@ -346,6 +388,24 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 	d.Set("active", c.LifecycleState == ess.Active)
 	d.Set("image_id", c.ImageId)
 	d.Set("instance_type", c.InstanceType)
+	if len(c.InstanceTypes.InstanceType) > 0 {
+		d.Set("instance_types", c.InstanceTypes.InstanceType)
+	} else {
+		d.Set("instance_types", []string{c.InstanceType})
+	}
+	if c.SpotStrategy != "" {
+		d.Set("spot_strategy", c.SpotStrategy)
+	}
+	var prices []map[string]interface{}
+	for _, price := range c.SpotPriceLimit.SpotPriceModel {
+		prices = append(prices, map[string]interface{}{
+			"instance_type": price.InstanceType,
+			"price_limit":   price.PriceLimit,
+		})
+	}
+	if err := d.Set("spot_price_limit", prices); err != nil {
+		return fmt.Errorf("Setting spot_price_limit got an error: %#v.", err)
+	}
 	d.Set("security_group_id", c.SecurityGroupId)
 	d.Set("scaling_configuration_name", c.ScalingConfigurationName)
 	d.Set("internet_charge_type", c.InternetChargeType)
@@ -425,14 +485,48 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 	})
 }
 
-func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingConfigurationArgs, error) {
-	args := &ess.CreateScalingConfigurationArgs{
+func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*EssCreateScalingConfigurationArgs, error) {
+	args := &EssCreateScalingConfigurationArgs{}
+	args.CreateScalingConfigurationArgs = ess.CreateScalingConfigurationArgs{
 		ScalingGroupId:  d.Get("scaling_group_id").(string),
 		ImageId:         d.Get("image_id").(string),
 		InstanceType:    d.Get("instance_type").(string),
 		SecurityGroupId: d.Get("security_group_id").(string),
 	}
 
+	// The order of instance_types is the priority to create instances.
+	if v, ok := d.GetOk("instance_types"); ok {
+		args.InstanceTypes = expandStringList(v.([]interface{}))
+	}
+	if args.InstanceType == "" && len(args.InstanceTypes) < 1 {
+		return nil, fmt.Errorf("One of 'instance_type' and 'instance_types' must be specified.")
+	}
+
+	args.SpotStrategy = d.Get("spot_strategy").(string)
+	prices := d.Get("spot_price_limit").([]interface{})
+	if len(prices) > 0 && ecs.SpotStrategyType(args.SpotStrategy) != ecs.SpotWithPriceLimit {
+		return nil, fmt.Errorf("'spot_price_limit' can only be set when 'spot_strategy' is %s.", ecs.SpotWithPriceLimit)
+	}
+	types := append([]string{args.InstanceType}, args.InstanceTypes...)
+	for _, v := range prices {
+		price := v.(map[string]interface{})
+		instanceType := price["instance_type"].(string)
+		found := false
+		for _, t := range types {
+			if t == instanceType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("The instance type %s of 'spot_price_limit' must be one of the instance types of the scaling configuration.", instanceType)
+		}
+		args.SpotPriceLimit = append(args.SpotPriceLimit, EssSpotPriceLimit{
+			InstanceType: instanceType,
+			PriceLimit:   price["price_limit"].(float64),
+		})
+	}
+
 	if v := d.Get("scaling_configuration_name").(string); v != "" {
 		args.ScalingConfigurationName = v
 	}
@@ -7,13 +7,12 @@ import (
 	"testing"
 
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudEssScalingConfiguration_basic(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -50,7 +49,7 @@ func TestAccAlicloudEssScalingConfiguration_basic(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingConfiguration_multiConfig(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -95,7 +94,7 @@ func TestAccAlicloudEssScalingConfiguration_multiConfig(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingConfiguration_active(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -132,7 +131,7 @@ func TestAccAlicloudEssScalingConfiguration_active(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingConfiguration_inactive(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -170,7 +169,7 @@ func TestAccAlicloudEssScalingConfiguration_inactive(t *testing.T) {
 
 // Skip test enable security group reseult
 func TestAccAlicloudEssScalingConfiguration_enable(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -211,7 +210,7 @@ func TestAccAlicloudEssScalingConfiguration_enable(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingConfiguration_disable(t *testing.T) {
-	var sc ess.ScalingConfigurationItemType
+	var sc EssScalingConfiguration
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -247,7 +246,7 @@ func TestAccAlicloudEssScalingConfiguration_disable(t *testing.T) {
 	})
 }
 
-func testAccCheckEssScalingConfigurationExists(n string, d *ess.ScalingConfigurationItemType) resource.TestCheckFunc {
+func testAccCheckEssScalingConfigurationExists(n string, d *EssScalingConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
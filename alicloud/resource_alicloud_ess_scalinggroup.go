@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-
 	"time"
 
 	"github.com/denverdino/aliyungo/ess"
@@ -78,6 +77,53 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 				MaxItems: 5,
 				MinItems: 1,
 			},
+			"multi_az_policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  PriorityMultiAZPolicy,
+				ValidateFunc: validateAllowedStringValue([]string{string(PriorityMultiAZPolicy),
+					string(BalanceMultiAZPolicy), string(CostOptimizedMultiAZPolicy)}),
+			},
+			"on_demand_base_capacity": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 1000),
+			},
+			"on_demand_percentage_above_base_capacity": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 100),
+			},
+			"instance_refresh": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      90,
+							ValidateFunc: validateIntegerInRange(0, 100),
+						},
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerInRange(1, 100),
+						},
+						"pause": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerInRange(0, 3600),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -89,10 +135,10 @@ func resourceAliyunEssScalingGroupCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
+	client := meta.(*AliyunClient)
 
 	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		scaling, err := essconn.CreateScalingGroup(args)
+		scaling, err := client.CreateEssScalingGroup(args)
 		if err != nil {
 			if IsExceptedError(err, EssThrottling) {
 				return resource.RetryableError(fmt.Errorf("CreateScalingGroup timeout and got an error: %#v.", err))
@@ -155,16 +201,34 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 	d.Set("vswitch_ids", vswitchIds)
+	if scaling.MultiAZPolicy != "" {
+		d.Set("multi_az_policy", scaling.MultiAZPolicy)
+	}
+	if MultiAZPolicy(d.Get("multi_az_policy").(string)) == CostOptimizedMultiAZPolicy {
+		d.Set("on_demand_base_capacity", scaling.OnDemandBaseCapacity)
+		d.Set("on_demand_percentage_above_base_capacity", scaling.OnDemandPercentageAboveBaseCapacity)
+	}
+
+	// The scaling configurations are applied after the scaling group, so switching the active one leaves the instances
+	// outdated. Removing instance_refresh from the state makes the next apply replace them.
+	if len(d.Get("instance_refresh").([]interface{})) > 0 && scaling.LifecycleState == ess.Active {
+		outdated, err := client.DescribeEssOutdatedInstances(scaling)
+		if err != nil {
+			return err
+		}
+		if len(outdated) > 0 {
+			d.Set("instance_refresh", nil)
+		}
+	}
 
 	return nil
 }
 
 func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).essconn
-	args := &ess.ModifyScalingGroupArgs{
-		ScalingGroupId: d.Id(),
-	}
+	client := meta.(*AliyunClient)
+	args := &EssModifyScalingGroupArgs{}
+	args.ScalingGroupId = d.Id()
 	d.Partial(true)
 
 	if d.HasChange("scaling_group_name") {
@@ -196,10 +260,31 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 		d.SetPartial("removal_policies")
 	}
 
-	if _, err := conn.ModifyScalingGroup(args); err != nil {
+	if d.HasChange("on_demand_base_capacity") || d.HasChange("on_demand_percentage_above_base_capacity") {
+		if err := validateEssCapacityPolicy(d); err != nil {
+			return err
+		}
+		base := d.Get("on_demand_base_capacity").(int)
+		percentage := d.Get("on_demand_percentage_above_base_capacity").(int)
+		args.OnDemandBaseCapacity = &base
+		args.OnDemandPercentageAboveBaseCapacity = &percentage
+		d.SetPartial("on_demand_base_capacity")
+		d.SetPartial("on_demand_percentage_above_base_capacity")
+	}
+
+	if err := client.ModifyEssScalingGroup(args); err != nil {
 		return err
 	}
 
+	if refreshes := d.Get("instance_refresh").([]interface{}); len(refreshes) > 0 && d.HasChange("instance_refresh") {
+		refresh := refreshes[0].(map[string]interface{})
+		if err := client.RefreshEssScalingGroupInstances(d.Id(), refresh["min_healthy_percentage"].(int),
+			refresh["batch_size"].(int), refresh["pause"].(int), EssInstanceRefreshTimeout); err != nil {
+			return err
+		}
+		d.SetPartial("instance_refresh")
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunEssScalingGroupRead(d, meta)
@@ -210,11 +295,11 @@ func resourceAliyunEssScalingGroupDelete(d *schema.ResourceData, meta interface{
 	return meta.(*AliyunClient).DeleteScalingGroupById(d.Id())
 }
 
-func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupArgs, error) {
+func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*EssCreateScalingGroupArgs, error) {
 	client := meta.(*AliyunClient)
-	args := &ess.CreateScalingGroupArgs{
-		RegionId: getRegion(d, meta),
-	}
+	args := &EssCreateScalingGroupArgs{}
+	args.RegionId = getRegion(d, meta)
+	args.MultiAZPolicy = d.Get("multi_az_policy").(string)
 
 	minsize := d.Get("min_size").(int)
 	maxsize := d.Get("max_size").(int)
@@ -254,5 +339,30 @@ func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{})
 		args.LoadBalancerIds = convertListToJsonString(lbs.(*schema.Set).List())
 	}
 
+	if err := validateEssCapacityPolicy(d); err != nil {
+		return nil, err
+	}
+	if v, ok := d.GetOk("on_demand_base_capacity"); ok {
+		base := v.(int)
+		args.OnDemandBaseCapacity = &base
+	}
+	if v, ok := d.GetOk("on_demand_percentage_above_base_capacity"); ok {
+		percentage := v.(int)
+		args.OnDemandPercentageAboveBaseCapacity = &percentage
+	}
+
 	return args, nil
 }
+
+// validateEssCapacityPolicy ensures the on-demand capacity is only set for the cost optimized scaling group.
+func validateEssCapacityPolicy(d *schema.ResourceData) error {
+	if MultiAZPolicy(d.Get("multi_az_policy").(string)) == CostOptimizedMultiAZPolicy {
+		return nil
+	}
+	_, hasBase := d.GetOk("on_demand_base_capacity")
+	_, hasPercentage := d.GetOk("on_demand_percentage_above_base_capacity")
+	if hasBase || hasPercentage {
+		return fmt.Errorf("'on_demand_base_capacity' and 'on_demand_percentage_above_base_capacity' can only be set when 'multi_az_policy' is %s.", CostOptimizedMultiAZPolicy)
+	}
+	return nil
+}
@@ -6,14 +6,13 @@ import (
 	"testing"
 
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ess"
 	"github.com/denverdino/aliyungo/slb"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudEssScalingGroup_basic(t *testing.T) {
-	var sg ess.ScalingGroupItemType
+	var sg EssScalingGroup
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -46,7 +45,7 @@ func TestAccAlicloudEssScalingGroup_basic(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingGroup_update(t *testing.T) {
-	var sg ess.ScalingGroupItemType
+	var sg EssScalingGroup
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -96,7 +95,7 @@ func TestAccAlicloudEssScalingGroup_update(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingGroup_vpc(t *testing.T) {
-	var sg ess.ScalingGroupItemType
+	var sg EssScalingGroup
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -132,7 +131,7 @@ func TestAccAlicloudEssScalingGroup_vpc(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingGroup_slb(t *testing.T) {
-	var sg ess.ScalingGroupItemType
+	var sg EssScalingGroup
 	var slb slb.LoadBalancerType
 
 	resource.Test(t, resource.TestCase{
@@ -168,7 +167,171 @@ func TestAccAlicloudEssScalingGroup_slb(t *testing.T) {
 
 }
 
-func testAccCheckEssScalingGroupExists(n string, d *ess.ScalingGroupItemType) resource.TestCheckFunc {
+func TestAccAlicloudEssScalingGroup_costOptimized(t *testing.T) {
+	var sg EssScalingGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingGroup_costOptimized(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists("alicloud_ess_scaling_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "multi_az_policy", "COST_OPTIMIZED"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "on_demand_base_capacity", "1"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "on_demand_percentage_above_base_capacity", "20"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_configuration.foo", "instance_types.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_configuration.foo", "spot_strategy", "SpotWithPriceLimit"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_configuration.foo", "spot_price_limit.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssScalingGroup_costOptimized(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists("alicloud_ess_scaling_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "on_demand_base_capacity", "2"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccAlicloudEssScalingGroup_instanceRefresh(t *testing.T) {
+	var sg EssScalingGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingGroup_instanceRefresh,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists("alicloud_ess_scaling_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.0.min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.0.batch_size", "1"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.0.pause", "30"),
+				),
+			},
+			// Switching the active scaling configuration leaves the instances outdated until the next apply.
+			resource.TestStep{
+				Config:             testAccEssScalingGroup_instanceRefreshSwitch,
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccEssScalingGroup_instanceRefreshSwitch,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists("alicloud_ess_scaling_group.foo", &sg),
+					testAccCheckEssScalingGroupRefreshed("alicloud_ess_scaling_group.foo"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudEssScalingGroup_instanceRefreshDefault(t *testing.T) {
+	var sg EssScalingGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_group.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingGroup_instanceRefreshDefault(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists("alicloud_ess_scaling_group.foo", &sg),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.0.min_healthy_percentage", "90"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_group.foo", "instance_refresh.0.batch_size", "1"),
+				),
+			},
+			resource.TestStep{
+				Config:             testAccEssScalingGroup_instanceRefreshDefault(false),
+				ExpectNonEmptyPlan: true,
+			},
+			// The scaling group has no room to launch the replacements first, so the only instance is replaced in place.
+			resource.TestStep{
+				Config: testAccEssScalingGroup_instanceRefreshDefault(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingGroupExists("alicloud_ess_scaling_group.foo", &sg),
+					testAccCheckEssScalingGroupRefreshed("alicloud_ess_scaling_group.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestEssInstanceRefreshBatch(t *testing.T) {
+	cases := []struct {
+		total, outdated, maxSize, percentage, batchSize int
+		batch                                           int
+		surge                                           bool
+	}{
+		// The default min healthy percentage still replaces one instance of a small group.
+		{total: 1, outdated: 1, maxSize: 1, percentage: 90, batchSize: 1, batch: 1},
+		{total: 5, outdated: 5, maxSize: 5, percentage: 90, batchSize: 3, batch: 1},
+		{total: 10, outdated: 10, maxSize: 10, percentage: 50, batchSize: 3, batch: 3},
+		{total: 10, outdated: 2, maxSize: 10, percentage: 50, batchSize: 3, batch: 2},
+		// The replacements are launched first when the max size leaves room for them.
+		{total: 2, outdated: 2, maxSize: 3, percentage: 90, batchSize: 2, batch: 1, surge: true},
+		{total: 2, outdated: 2, maxSize: 10, percentage: 90, batchSize: 3, batch: 2, surge: true},
+	}
+	for _, c := range cases {
+		batch, surge := essInstanceRefreshBatch(c.total, c.outdated, c.maxSize, c.percentage, c.batchSize)
+		if batch != c.batch || surge != c.surge {
+			t.Fatalf("The refresh batch of %#v should be %d (surge %t), got %d (surge %t).", c, c.batch, c.surge, batch, surge)
+		}
+	}
+}
+
+func testAccCheckEssScalingGroupRefreshed(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+		group, err := client.DescribeScalingGroupById(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		outdated, err := client.DescribeEssOutdatedInstances(group)
+		if err != nil {
+			return err
+		}
+		if len(outdated) > 0 {
+			return fmt.Errorf("The instances %v of scaling group %s are still outdated.", outdated, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckEssScalingGroupExists(n string, d *EssScalingGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -368,3 +531,234 @@ resource "alicloud_slb_listener" "tcp" {
   health_check_type = "tcp"
 }
 `
+
+func testAccEssScalingGroup_costOptimized(base int) string {
+	return fmt.Sprintf(`
+data "alicloud_images" "ecs_image" {
+  most_recent = true
+  name_regex =  "^centos_6\\w{1,5}[64].*"
+}
+
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  	name = "tf_test_foo"
+  	cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  	vpc_id = "${alicloud_vpc.foo.id}"
+  	cidr_block = "172.16.0.0/24"
+  	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	description = "foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 0
+	max_size = 4
+	scaling_group_name = "sg-for-test-cost-optimized"
+	vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+	removal_policies = ["OldestInstance", "NewestInstance"]
+	multi_az_policy = "COST_OPTIMIZED"
+	on_demand_base_capacity = %d
+	on_demand_percentage_above_base_capacity = 20
+}
+
+resource "alicloud_ess_scaling_configuration" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_types = ["ecs.n4.large", "ecs.sn1ne.large"]
+	spot_strategy = "SpotWithPriceLimit"
+	spot_price_limit = [
+	  {
+		instance_type = "ecs.n4.large"
+		price_limit = 0.5
+	  },
+	  {
+		instance_type = "ecs.sn1ne.large"
+		price_limit = 0.6
+	  }
+	]
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+	force_delete = "true"
+}
+`, base)
+}
+
+const testAccEssScalingGroup_instanceRefresh = `
+data "alicloud_images" "ecs_image" {
+  most_recent = true
+  name_regex =  "^centos_6\\w{1,5}[64].*"
+}
+
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  	name = "tf_test_foo"
+  	cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  	vpc_id = "${alicloud_vpc.foo.id}"
+  	cidr_block = "172.16.0.0/24"
+  	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	description = "foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 2
+	max_size = 3
+	scaling_group_name = "sg-for-test-instance-refresh"
+	vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+	removal_policies = ["OldestInstance", "NewestInstance"]
+	instance_refresh {
+		min_healthy_percentage = 50
+		batch_size = 1
+		pause = 30
+	}
+}
+
+resource "alicloud_ess_scaling_configuration" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	enable = true
+	active = true
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_type = "ecs.n4.large"
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+	force_delete = "true"
+}
+`
+
+const testAccEssScalingGroup_instanceRefreshSwitch = `
+data "alicloud_images" "ecs_image" {
+  most_recent = true
+  name_regex =  "^centos_6\\w{1,5}[64].*"
+}
+
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  	name = "tf_test_foo"
+  	cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  	vpc_id = "${alicloud_vpc.foo.id}"
+  	cidr_block = "172.16.0.0/24"
+  	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	description = "foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 2
+	max_size = 3
+	scaling_group_name = "sg-for-test-instance-refresh"
+	vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+	removal_policies = ["OldestInstance", "NewestInstance"]
+	instance_refresh {
+		min_healthy_percentage = 50
+		batch_size = 1
+		pause = 30
+	}
+}
+
+resource "alicloud_ess_scaling_configuration" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	enable = true
+	active = false
+	substitute = "${alicloud_ess_scaling_configuration.bar.id}"
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_type = "ecs.n4.large"
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+	force_delete = "true"
+}
+
+resource "alicloud_ess_scaling_configuration" "bar" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_type = "ecs.n4.large"
+	instance_name = "ESS-Instance-Refreshed"
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+}
+`
+
+func testAccEssScalingGroup_instanceRefreshDefault(active bool) string {
+	return fmt.Sprintf(`
+data "alicloud_images" "ecs_image" {
+  most_recent = true
+  name_regex =  "^centos_6\\w{1,5}[64].*"
+}
+
+data "alicloud_zones" "default" {
+	"available_disk_category"= "cloud_efficiency"
+	"available_resource_creation"= "VSwitch"
+}
+
+resource "alicloud_vpc" "foo" {
+  	name = "tf_test_foo"
+  	cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "foo" {
+  	vpc_id = "${alicloud_vpc.foo.id}"
+  	cidr_block = "172.16.0.0/24"
+  	availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+
+resource "alicloud_security_group" "tf_test_foo" {
+	description = "foo"
+	vpc_id = "${alicloud_vpc.foo.id}"
+}
+
+resource "alicloud_ess_scaling_group" "foo" {
+	min_size = 1
+	max_size = 1
+	scaling_group_name = "sg-for-test-instance-refresh-default"
+	vswitch_ids = ["${alicloud_vswitch.foo.id}"]
+	removal_policies = ["OldestInstance", "NewestInstance"]
+	instance_refresh {
+		pause = 10
+	}
+}
+
+resource "alicloud_ess_scaling_configuration" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	enable = true
+	active = %t
+	substitute = "${alicloud_ess_scaling_configuration.bar.id}"
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_type = "ecs.n4.large"
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+	force_delete = "true"
+}
+
+resource "alicloud_ess_scaling_configuration" "bar" {
+	scaling_group_id = "${alicloud_ess_scaling_group.foo.id}"
+	image_id = "${data.alicloud_images.ecs_image.images.0.id}"
+	instance_type = "ecs.n4.large"
+	instance_name = "ESS-Instance-Refreshed"
+	security_group_id = "${alicloud_security_group.tf_test_foo.id}"
+}
+`, active)
+}
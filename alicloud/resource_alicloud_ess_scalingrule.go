@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,15 +23,66 @@ func resourceAlicloudEssScalingRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"scaling_rule_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  SimpleScalingRule,
+				ValidateFunc: validateAllowedStringValue([]string{
+					SimpleScalingRule, TargetTrackingScalingRule, StepScalingRule}),
+			},
 			"adjustment_type": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ValidateFunc: validateAllowedStringValue([]string{string(ess.QuantityChangeInCapacity),
 					string(ess.PercentChangeInCapacity), string(ess.TotalCapacity)}),
 			},
 			"adjustment_value": &schema.Schema{
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+			},
+			"metric_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_value": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"disable_scale_in": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"estimated_instance_warmup": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 86400),
+			},
+			"step_adjustments": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_interval_lower_bound": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateFloatString,
+							DiffSuppressFunc: floatStringDiffSuppressFunc,
+						},
+						"metric_interval_upper_bound": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateFloatString,
+							DiffSuppressFunc: floatStringDiffSuppressFunc,
+						},
+						"scaling_adjustment": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
 			},
 			"scaling_rule_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -57,9 +109,7 @@ func resourceAliyunEssScalingRuleCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	essconn := meta.(*AliyunClient).essconn
-
-	rule, err := essconn.CreateScalingRule(args)
+	rule, err := meta.(*AliyunClient).CreateEssScalingRule(args)
 	if err != nil {
 		return err
 	}
@@ -89,6 +139,28 @@ func resourceAliyunEssScalingRuleRead(d *schema.ResourceData, meta interface{})
 	d.Set("adjustment_value", rule.AdjustmentValue)
 	d.Set("scaling_rule_name", rule.ScalingRuleName)
 	d.Set("cooldown", rule.Cooldown)
+	d.Set("scaling_rule_type", rule.ScalingRuleType)
+	d.Set("metric_name", rule.MetricName)
+	d.Set("target_value", rule.TargetValue)
+	d.Set("disable_scale_in", rule.DisableScaleIn)
+	d.Set("estimated_instance_warmup", rule.EstimatedInstanceWarmup)
+
+	var steps []map[string]interface{}
+	for _, step := range rule.StepAdjustments.StepAdjustment {
+		mapping := map[string]interface{}{
+			"scaling_adjustment": step.ScalingAdjustment,
+		}
+		if step.MetricIntervalLowerBound != nil {
+			mapping["metric_interval_lower_bound"] = strconv.FormatFloat(*step.MetricIntervalLowerBound, 'f', -1, 64)
+		}
+		if step.MetricIntervalUpperBound != nil {
+			mapping["metric_interval_upper_bound"] = strconv.FormatFloat(*step.MetricIntervalUpperBound, 'f', -1, 64)
+		}
+		steps = append(steps, mapping)
+	}
+	if err := d.Set("step_adjustments", steps); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -118,13 +190,16 @@ func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}
 
 func resourceAliyunEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*AliyunClient).essconn
+	client := meta.(*AliyunClient)
 	ids := strings.Split(d.Id(), COLON_SEPARATED)
 
-	args := &ess.ModifyScalingRuleArgs{
-		ScalingRuleId: ids[1],
+	if err := checkEssScalingRuleType(d); err != nil {
+		return err
 	}
 
+	args := &EssModifyScalingRuleArgs{}
+	args.ScalingRuleId = ids[1]
+
 	if d.HasChange("adjustment_type") {
 		args.AdjustmentType = ess.AdjustmentType(d.Get("adjustment_type").(string))
 	}
@@ -141,20 +216,58 @@ func resourceAliyunEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}
 		args.Cooldown = d.Get("cooldown").(int)
 	}
 
-	if _, err := conn.ModifyScalingRule(args); err != nil {
+	if d.HasChange("metric_name") {
+		args.MetricName = d.Get("metric_name").(string)
+	}
+
+	if d.HasChange("target_value") {
+		args.TargetValue = strconv.FormatFloat(d.Get("target_value").(float64), 'f', -1, 64)
+	}
+
+	if d.HasChange("disable_scale_in") {
+		args.DisableScaleIn = strconv.FormatBool(d.Get("disable_scale_in").(bool))
+	}
+
+	if d.HasChange("estimated_instance_warmup") {
+		args.EstimatedInstanceWarmup = d.Get("estimated_instance_warmup").(int)
+	}
+
+	if d.HasChange("step_adjustments") {
+		args.StepAdjustment = expandEssStepAdjustments(d.Get("step_adjustments").([]interface{}))
+	}
+
+	if err := client.ModifyEssScalingRule(args); err != nil {
 		return err
 	}
 
 	return resourceAliyunEssScalingRuleRead(d, meta)
 }
 
-func buildAlicloudEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingRuleArgs, error) {
-	args := &ess.CreateScalingRuleArgs{
+func buildAlicloudEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*EssCreateScalingRuleArgs, error) {
+	args := &EssCreateScalingRuleArgs{}
+	args.CreateScalingRuleArgs = ess.CreateScalingRuleArgs{
 		RegionId:        getRegion(d, meta),
 		ScalingGroupId:  d.Get("scaling_group_id").(string),
 		AdjustmentType:  ess.AdjustmentType(d.Get("adjustment_type").(string)),
 		AdjustmentValue: d.Get("adjustment_value").(int),
 	}
+	args.ScalingRuleType = d.Get("scaling_rule_type").(string)
+
+	if err := checkEssScalingRuleType(d); err != nil {
+		return nil, err
+	}
+	switch args.ScalingRuleType {
+	case TargetTrackingScalingRule:
+		args.MetricName = d.Get("metric_name").(string)
+		args.TargetValue = strconv.FormatFloat(d.Get("target_value").(float64), 'f', -1, 64)
+		args.DisableScaleIn = strconv.FormatBool(d.Get("disable_scale_in").(bool))
+	case StepScalingRule:
+		args.StepAdjustment = expandEssStepAdjustments(d.Get("step_adjustments").([]interface{}))
+	}
+
+	if v, ok := d.GetOk("estimated_instance_warmup"); ok && args.ScalingRuleType != SimpleScalingRule {
+		args.EstimatedInstanceWarmup = v.(int)
+	}
 
 	if v := d.Get("scaling_rule_name").(string); v != "" {
 		args.ScalingRuleName = v
@@ -166,3 +279,48 @@ func buildAlicloudEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (
 
 	return args, nil
 }
+
+// checkEssScalingRuleType checks that only the arguments of the scaling rule type are set.
+func checkEssScalingRuleType(d *schema.ResourceData) error {
+	ruleType := d.Get("scaling_rule_type").(string)
+	adjustmentType := d.Get("adjustment_type").(string)
+	_, hasMetric := d.GetOk("metric_name")
+	_, hasTarget := d.GetOk("target_value")
+	steps := d.Get("step_adjustments").([]interface{})
+	switch ruleType {
+	case TargetTrackingScalingRule:
+		if !hasMetric || !hasTarget {
+			return fmt.Errorf("'metric_name' and 'target_value' are required when 'scaling_rule_type' is %s.", TargetTrackingScalingRule)
+		}
+		if adjustmentType != "" || len(steps) > 0 {
+			return fmt.Errorf("'adjustment_type' and 'step_adjustments' can not be set when 'scaling_rule_type' is %s.", TargetTrackingScalingRule)
+		}
+	case StepScalingRule:
+		if adjustmentType == "" || len(steps) < 1 {
+			return fmt.Errorf("'adjustment_type' and 'step_adjustments' are required when 'scaling_rule_type' is %s.", StepScalingRule)
+		}
+		if hasMetric || hasTarget {
+			return fmt.Errorf("'metric_name' and 'target_value' can not be set when 'scaling_rule_type' is %s.", StepScalingRule)
+		}
+	default:
+		if adjustmentType == "" {
+			return fmt.Errorf("'adjustment_type' is required when 'scaling_rule_type' is %s.", SimpleScalingRule)
+		}
+		if hasMetric || hasTarget || len(steps) > 0 {
+			return fmt.Errorf("'metric_name', 'target_value' and 'step_adjustments' can not be set when 'scaling_rule_type' is %s.", SimpleScalingRule)
+		}
+	}
+	return nil
+}
+
+func expandEssStepAdjustments(list []interface{}) (steps []EssStepAdjustment) {
+	for _, v := range list {
+		step := v.(map[string]interface{})
+		steps = append(steps, EssStepAdjustment{
+			MetricIntervalLowerBound: step["metric_interval_lower_bound"].(string),
+			MetricIntervalUpperBound: step["metric_interval_upper_bound"].(string),
+			ScalingAdjustment:        step["scaling_adjustment"].(int),
+		})
+	}
+	return
+}
@@ -7,13 +7,12 @@ import (
 	"testing"
 
 	"github.com/denverdino/aliyungo/common"
-	"github.com/denverdino/aliyungo/ess"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAlicloudEssScalingRule_basic(t *testing.T) {
-	var sc ess.ScalingRuleItemType
+	var sc EssScalingRule
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -46,7 +45,7 @@ func TestAccAlicloudEssScalingRule_basic(t *testing.T) {
 }
 
 func TestAccAlicloudEssScalingRule_update(t *testing.T) {
-	var sc ess.ScalingRuleItemType
+	var sc EssScalingRule
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -98,7 +97,76 @@ func TestAccAlicloudEssScalingRule_update(t *testing.T) {
 	})
 }
 
-func testAccCheckEssScalingRuleExists(n string, d *ess.ScalingRuleItemType) resource.TestCheckFunc {
+func TestAccAlicloudEssScalingRule_targetTracking(t *testing.T) {
+	var sc EssScalingRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingRuleConfig_targetTracking,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingRuleExists("alicloud_ess_scaling_rule.foo", &sc),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "scaling_rule_type", "TargetTrackingScalingRule"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "metric_name", "CpuUtilization"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "target_value", "60"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "disable_scale_in", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEssScalingRuleConfig_targetTrackingUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingRuleExists("alicloud_ess_scaling_rule.foo", &sc),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "target_value", "75.5"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "disable_scale_in", "true"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "estimated_instance_warmup", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudEssScalingRule_step(t *testing.T) {
+	var sc EssScalingRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: "alicloud_ess_scaling_rule.foo",
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEssScalingRuleConfig_step,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEssScalingRuleExists("alicloud_ess_scaling_rule.foo", &sc),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "scaling_rule_type", "StepScalingRule"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "adjustment_type", "QuantityChangeInCapacity"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "step_adjustments.#", "2"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "step_adjustments.0.metric_interval_lower_bound", "0"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "step_adjustments.0.metric_interval_upper_bound", "10"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "step_adjustments.0.scaling_adjustment", "1"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "step_adjustments.1.metric_interval_lower_bound", "10"),
+					resource.TestCheckResourceAttr("alicloud_ess_scaling_rule.foo", "step_adjustments.1.scaling_adjustment", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEssScalingRuleExists(n string, d *EssScalingRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -286,3 +354,63 @@ resource "alicloud_ess_scaling_rule" "foo" {
 	cooldown = 60
 }
 `
+
+const testAccEssScalingRuleConfig_targetTracking = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "tf-test-target-tracking"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	scaling_rule_type = "TargetTrackingScalingRule"
+	metric_name = "CpuUtilization"
+	target_value = 60
+}
+`
+
+const testAccEssScalingRuleConfig_targetTrackingUpdate = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 2
+	scaling_group_name = "tf-test-target-tracking"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	scaling_rule_type = "TargetTrackingScalingRule"
+	metric_name = "CpuUtilization"
+	target_value = 75.5
+	disable_scale_in = true
+	estimated_instance_warmup = 120
+}
+`
+
+const testAccEssScalingRuleConfig_step = `
+resource "alicloud_ess_scaling_group" "bar" {
+	min_size = 0
+	max_size = 4
+	scaling_group_name = "tf-test-step"
+	removal_policies = ["OldestInstance", "NewestInstance"]
+}
+
+resource "alicloud_ess_scaling_rule" "foo" {
+	scaling_group_id = "${alicloud_ess_scaling_group.bar.id}"
+	scaling_rule_type = "StepScalingRule"
+	adjustment_type = "QuantityChangeInCapacity"
+	step_adjustments = [
+	  {
+		metric_interval_lower_bound = "0"
+		metric_interval_upper_bound = "10"
+		scaling_adjustment = 1
+	  },
+	  {
+		metric_interval_lower_bound = "10"
+		scaling_adjustment = 2
+	  }
+	]
+}
+`
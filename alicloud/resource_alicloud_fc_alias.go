@@ -0,0 +1,130 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcAliasCreate,
+		Read:   resourceAlicloudFcAliasRead,
+		Update: resourceAlicloudFcAliasUpdate,
+		Delete: resourceAlicloudFcAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// The weights of the additional versions, like {"2" = 0.05} routes 5% of the traffic to the version 2.
+			"additional_version_weight": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	serviceName := d.Get("service_name").(string)
+	aliasName := d.Get("alias_name").(string)
+
+	request := NewFcCommonRequest("/services/%s/aliases", serviceName)
+	request.Payload = buildFcAlias(d)
+
+	if err := client.ProcessFcCommonRequest(http.MethodPost, request, nil); err != nil {
+		return fmt.Errorf("Creating alias of function compute got an error: %#v", err)
+	}
+
+	d.SetId(serviceName + COMMA_SEPARATED + aliasName)
+
+	return resourceAlicloudFcAliasRead(d, meta)
+}
+
+func resourceAlicloudFcAliasRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+	alias, err := client.DescribeFcAlias(parameters[0], parameters[1])
+
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("service_name", parameters[0])
+	d.Set("alias_name", alias.AliasName)
+	d.Set("version_id", alias.VersionId)
+	d.Set("description", alias.Description)
+	d.Set("additional_version_weight", alias.AdditionalVersionWeight)
+
+	return nil
+}
+
+func resourceAlicloudFcAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	if d.HasChange("version_id") || d.HasChange("description") || d.HasChange("additional_version_weight") {
+		request := NewFcCommonRequest("/services/%s/aliases/%s", parameters[0], parameters[1])
+		request.Payload = buildFcAlias(d)
+
+		if err := client.ProcessFcCommonRequest(http.MethodPut, request, nil); err != nil {
+			return fmt.Errorf("Updating alias of function compute got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudFcAliasRead(d, meta)
+}
+
+func resourceAlicloudFcAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	request := NewFcCommonRequest("/services/%s/aliases/%s", parameters[0], parameters[1])
+	if err := client.ProcessFcCommonRequest(http.MethodDelete, request, nil); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Deleting alias of function compute got an error: %#v", err)
+	}
+	return nil
+}
+
+func buildFcAlias(d *schema.ResourceData) *FcAlias {
+	weight := make(map[string]float64)
+	for k, v := range d.Get("additional_version_weight").(map[string]interface{}) {
+		weight[k] = v.(float64)
+	}
+	return &FcAlias{
+		AliasName:               d.Get("alias_name").(string),
+		VersionId:               d.Get("version_id").(string),
+		Description:             d.Get("description").(string),
+		AdditionalVersionWeight: weight,
+	}
+}
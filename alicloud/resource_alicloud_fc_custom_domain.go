@@ -0,0 +1,246 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcCustomDomainCreate,
+		Read:   resourceAlicloudFcCustomDomainRead,
+		Update: resourceAlicloudFcCustomDomainUpdate,
+		Delete: resourceAlicloudFcCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"HTTP", "HTTP,HTTPS"}),
+			},
+			"route_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"function_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"qualifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"methods": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAllowedStringValue([]string{"GET", "POST", "PUT", "DELETE", "HEAD", "PATCH"}),
+							},
+						},
+					},
+				},
+			},
+			"cert_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"certificate": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"private_key": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			// The custom domain should be resolved to the cname by CNAME record, like alicloud_dns_record.
+			"cname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	domain, err := buildFcCustomDomain(d)
+	if err != nil {
+		return fmt.Errorf("Creating custom domain of function compute got an error: %#v", err)
+	}
+	request := NewFcCommonRequest("/custom-domains")
+	request.Payload = domain
+
+	if err := client.ProcessFcCommonRequest(http.MethodPost, request, nil); err != nil {
+		return fmt.Errorf("Creating custom domain of function compute got an error: %#v", err)
+	}
+
+	d.SetId(domain.DomainName)
+
+	return resourceAlicloudFcCustomDomainRead(d, meta)
+}
+
+func resourceAlicloudFcCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	domain, err := client.DescribeFcCustomDomain(d.Id())
+
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("domain_name", domain.DomainName)
+	d.Set("protocol", domain.Protocol)
+	d.Set("api_version", domain.ApiVersion)
+	d.Set("creation_time", domain.CreatedTime)
+	d.Set("last_modified_time", domain.LastModifiedTime)
+
+	_, host := fc.GetAccessPoint(client.fcconn.Config.Endpoint)
+	d.Set("cname", host)
+
+	var routes []map[string]interface{}
+	if domain.RouteConfig != nil {
+		for _, route := range domain.RouteConfig.Routes {
+			routes = append(routes, map[string]interface{}{
+				"path":          route.Path,
+				"service_name":  route.ServiceName,
+				"function_name": route.FunctionName,
+				"qualifier":     route.Qualifier,
+				"methods":       route.Methods,
+			})
+		}
+	}
+	if err := d.Set("route_config", routes); err != nil {
+		return fmt.Errorf("Setting route_config got an error: %#v.", err)
+	}
+
+	var certs []map[string]interface{}
+	if domain.CertConfig != nil && domain.CertConfig.CertName != "" {
+		// The private key is not returned, so keep the configured one.
+		privateKey := ""
+		if v, ok := d.GetOk("cert_config.0.private_key"); ok {
+			privateKey = v.(string)
+		}
+		certs = append(certs, map[string]interface{}{
+			"cert_name":   domain.CertConfig.CertName,
+			"certificate": domain.CertConfig.Certificate,
+			"private_key": privateKey,
+		})
+	}
+	if err := d.Set("cert_config", certs); err != nil {
+		return fmt.Errorf("Setting cert_config got an error: %#v.", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudFcCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	if d.HasChange("protocol") || d.HasChange("route_config") || d.HasChange("cert_config") {
+		domain, err := buildFcCustomDomain(d)
+		if err != nil {
+			return fmt.Errorf("Updating custom domain of function compute got an error: %#v", err)
+		}
+		request := NewFcCommonRequest("/custom-domains/%s", d.Id())
+		request.Payload = domain
+
+		if err := client.ProcessFcCommonRequest(http.MethodPut, request, nil); err != nil {
+			return fmt.Errorf("Updating custom domain of function compute got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudFcCustomDomainRead(d, meta)
+}
+
+func resourceAlicloudFcCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+
+	request := NewFcCommonRequest("/custom-domains/%s", d.Id())
+	if err := client.ProcessFcCommonRequest(http.MethodDelete, request, nil); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Deleting custom domain of function compute got an error: %#v", err)
+	}
+	return nil
+}
+
+func buildFcCustomDomain(d *schema.ResourceData) (*FcCustomDomain, error) {
+	domain := &FcCustomDomain{
+		DomainName:  d.Get("domain_name").(string),
+		Protocol:    d.Get("protocol").(string),
+		RouteConfig: &FcRouteConfig{Routes: []FcPathConfig{}},
+	}
+
+	for _, r := range d.Get("route_config").([]interface{}) {
+		route := r.(map[string]interface{})
+		domain.RouteConfig.Routes = append(domain.RouteConfig.Routes, FcPathConfig{
+			Path:         route["path"].(string),
+			ServiceName:  route["service_name"].(string),
+			FunctionName: route["function_name"].(string),
+			Qualifier:    route["qualifier"].(string),
+			Methods:      expandStringList(route["methods"].([]interface{})),
+		})
+	}
+
+	if certs := d.Get("cert_config").([]interface{}); len(certs) > 0 {
+		if domain.Protocol != "HTTP,HTTPS" {
+			return nil, fmt.Errorf("'cert_config' is only valid when 'protocol' is 'HTTP,HTTPS'.")
+		}
+		cert := certs[0].(map[string]interface{})
+		domain.CertConfig = &FcCertConfig{
+			CertName:    cert["cert_name"].(string),
+			Certificate: cert["certificate"].(string),
+			PrivateKey:  cert["private_key"].(string),
+		}
+	}
+
+	return domain, nil
+}
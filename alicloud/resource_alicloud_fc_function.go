@@ -2,8 +2,7 @@ package alicloud
 
 import (
 	"fmt"
-	"io/ioutil"
-	"os"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -55,13 +54,96 @@ func resourceAlicloudFcFunction() *schema.Resource {
 				Default:  128,
 			},
 			"code": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"oss_bucket", "oss_key", "filename", "content"},
+				StateFunc:     fcCodeSourceHash,
+			},
+			"oss_bucket": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"code", "filename", "content"},
+			},
+			"oss_key": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"code", "filename", "content"},
+			},
+			"filename": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"code", "oss_bucket", "oss_key"},
+			},
+			"content": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"code", "oss_bucket", "oss_key"},
 			},
 			"environment_variables": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"initializer": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"initialization_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 300),
+			},
+			"instance_concurrency": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
+			"ca_port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"custom_container_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"args": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"layers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"code_checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"code_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			// Computed values
 			"alicloud_fc_function": {
 				Type:     schema.TypeList,
@@ -131,6 +213,11 @@ func resourceAlicloudFcFunctionCreate(d *schema.ResourceData, meta interface{})
 	client := meta.(*AliyunClient)
 	serviceName := d.Get("service_name").(string)
 	functionName := d.Get("function_name").(string)
+	// The code sources are checked before any request is sent.
+	code, err := buildFcFunctionCode(d)
+	if err != nil {
+		return fmt.Errorf("Creating function of function compute got an error: %#v", err)
+	}
 	createFunctionInput := fc.NewCreateFunctionInput(serviceName)
 
 	createFunctionInput.WithFunctionName(functionName)
@@ -153,25 +240,7 @@ func resourceAlicloudFcFunctionCreate(d *schema.ResourceData, meta interface{})
 			createFunctionInput.WithMemorySize(int32(memorySize))
 		}
 	}
-	code := d.Get("code").(string)
-	if _, err := os.Stat(code); err != nil {
-		if os.IsNotExist(err) {
-			// Does not exist
-			return fmt.Errorf("Creating function of function compute got an error: %#v", "File or directory does not exist")
-		} else {
-			// Other error
-			return fmt.Errorf("Creating function of function compute got an error: %#v", err)
-		}
-	}
-	if strings.HasSuffix(code, ".zip") || strings.HasSuffix(code, ".jar") {
-		if zipFile, err := ioutil.ReadFile(code); err != nil {
-			return fmt.Errorf("Creating function of function compute got an error: %#v", err)
-		} else {
-			createFunctionInput.WithCode(fc.NewCode().WithZipFile(zipFile))
-		}
-	} else {
-		createFunctionInput.WithCode(fc.NewCode().WithDir(code))
-	}
+	createFunctionInput.WithCode(code)
 	if environmentVariables, ok := d.GetOk("environment_variables"); ok {
 		environmentVariablesStr := make(map[string]string)
 		for k, v := range environmentVariables.(map[string]interface{}) {
@@ -180,8 +249,13 @@ func resourceAlicloudFcFunctionCreate(d *schema.ResourceData, meta interface{})
 		createFunctionInput.WithEnvironmentVariables(environmentVariablesStr)
 	}
 
-	_, err := client.fcconn.CreateFunction(createFunctionInput)
-	if err != nil {
+	// The runtime settings are not supported by the fc-go-sdk, so the function is created by common request.
+	request := NewFcCommonRequest("/services/%s/functions", serviceName)
+	request.Payload = struct {
+		fc.FunctionCreateObject
+		FcFunctionRuntimeConfig
+	}{createFunctionInput.FunctionCreateObject, buildFcFunctionRuntimeConfig(d, false)}
+	if err := client.ProcessFcCommonRequest(http.MethodPost, request, nil); err != nil {
 		return fmt.Errorf("Creating function of function compute got an error: %#v", err)
 	}
 
@@ -193,7 +267,7 @@ func resourceAlicloudFcFunctionCreate(d *schema.ResourceData, meta interface{})
 func resourceAlicloudFcFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
-	getFunctionOutput, err := client.fcconn.GetFunction(fc.NewGetFunctionInput(parameters[0], parameters[1]))
+	function, err := client.DescribeFcFunction(parameters[0], parameters[1])
 
 	if err != nil {
 		if NotFoundError(err) {
@@ -204,29 +278,64 @@ func resourceAlicloudFcFunctionRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Set("service_name", parameters[0])
-	d.Set("function_name", getFunctionOutput.FunctionName)
-	d.Set("description", getFunctionOutput.Description)
-	d.Set("runtime", getFunctionOutput.Runtime)
-	d.Set("handler", getFunctionOutput.Handler)
-	d.Set("timeout", getFunctionOutput.Timeout)
-	d.Set("memory_size", getFunctionOutput.MemorySize)
-	d.Set("environment_variables", getFunctionOutput.EnvironmentVariables)
-	d.Set("code", "")
+	d.Set("function_name", function.FunctionName)
+	d.Set("description", function.Description)
+	d.Set("runtime", function.Runtime)
+	d.Set("handler", function.Handler)
+	d.Set("timeout", function.Timeout)
+	d.Set("memory_size", function.MemorySize)
+	d.Set("environment_variables", function.EnvironmentVariables)
+	// The code updated outside Terraform is deployed again by removing its source from the state.
+	if checksum := d.Get("code_checksum").(string); checksum != "" && checksum != function.CodeChecksum {
+		for _, key := range []string{"code", "oss_key", "content"} {
+			if _, ok := d.GetOk(key); ok {
+				d.Set(key, "")
+			}
+		}
+	}
+	d.Set("code_checksum", function.CodeChecksum)
+	d.Set("code_size", function.CodeSize)
+	d.Set("last_modified_time", function.LastModifiedTime)
+
+	if function.Initializer != nil {
+		d.Set("initializer", *function.Initializer)
+	}
+	if function.InitializationTimeout != nil {
+		d.Set("initialization_timeout", *function.InitializationTimeout)
+	}
+	if function.InstanceConcurrency != nil {
+		d.Set("instance_concurrency", *function.InstanceConcurrency)
+	}
+	if function.CAPort != nil {
+		d.Set("ca_port", *function.CAPort)
+	}
+	var containers []map[string]interface{}
+	if function.CustomContainerConfig != nil && function.CustomContainerConfig.Image != "" {
+		containers = append(containers, map[string]interface{}{
+			"image":   function.CustomContainerConfig.Image,
+			"command": function.CustomContainerConfig.Command,
+			"args":    function.CustomContainerConfig.Args,
+		})
+	}
+	if err := d.Set("custom_container_config", containers); err != nil {
+		return fmt.Errorf("Setting custom_container_config got an error: %#v.", err)
+	}
+	d.Set("layers", function.Layers)
 
 	var s []map[string]interface{}
 	mapping := map[string]interface{}{
-		"id":                    *getFunctionOutput.FunctionID,
-		"name":                  *getFunctionOutput.FunctionName,
+		"id":                    function.FunctionId,
+		"name":                  function.FunctionName,
 		"status":                "Available",
-		"creation_time":         *getFunctionOutput.CreatedTime,
-		"description":           *getFunctionOutput.Description,
-		"runtime":               *getFunctionOutput.Runtime,
-		"handler":               *getFunctionOutput.Handler,
-		"timeout":               strconv.Itoa(int(*getFunctionOutput.Timeout)),
-		"memory_size":           strconv.Itoa(int(*getFunctionOutput.MemorySize)),
-		"code_size":             strconv.FormatInt(*getFunctionOutput.CodeSize, 10),
-		"last_modified_time":    *getFunctionOutput.LastModifiedTime,
-		"environment_variables": getFunctionOutput.EnvironmentVariables,
+		"creation_time":         function.CreatedTime,
+		"description":           function.Description,
+		"runtime":               function.Runtime,
+		"handler":               function.Handler,
+		"timeout":               strconv.Itoa(int(function.Timeout)),
+		"memory_size":           strconv.Itoa(int(function.MemorySize)),
+		"code_size":             strconv.FormatInt(function.CodeSize, 10),
+		"last_modified_time":    function.LastModifiedTime,
+		"environment_variables": function.EnvironmentVariables,
 		"resource_type":         "alicloud_fc_function",
 	}
 	s = append(s, mapping)
@@ -247,28 +356,21 @@ func resourceAlicloudFcFunctionUpdate(d *schema.ResourceData, meta interface{})
 
 	updateFunctionInput := fc.NewUpdateFunctionInput(parameters[0], parameters[1])
 
-	if d.HasChange("description") {
+	if fcFunctionCodeChanged(d) {
 		update = true
-		code := d.Get("code").(string)
-		if _, err := os.Stat(code); err != nil {
-			if os.IsNotExist(err) {
-				// Does not exist
-				return fmt.Errorf("Creating function of function compute got an error: %#v", "File or directory does not exist")
-			} else {
-				// Other error
-				return fmt.Errorf("Creating function of function compute got an error: %#v", err)
-			}
-		}
-		if strings.HasSuffix(code, ".zip") {
-			if zipFile, err := ioutil.ReadFile(code); err != nil {
-				return fmt.Errorf("Creating function of function compute got an error: %#v", err)
-			} else {
-				updateFunctionInput.WithCode(fc.NewCode().WithZipFile(zipFile))
-			}
-		} else {
-			updateFunctionInput.WithCode(fc.NewCode().WithDir(code))
+		code, err := buildFcFunctionCode(d)
+		if err != nil {
+			return fmt.Errorf("Updating function of function compute got an error: %#v", err)
 		}
+		updateFunctionInput.WithCode(code)
+		// The checksum of the new code is read back after updating.
+		d.Set("code_checksum", "")
 		d.SetPartial("code")
+		d.SetPartial("oss_bucket")
+		d.SetPartial("oss_key")
+		d.SetPartial("filename")
+		d.SetPartial("content")
+		d.SetPartial("code_checksum")
 	}
 
 	if d.HasChange("description") {
@@ -315,8 +417,21 @@ func resourceAlicloudFcFunctionUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("environment_variables")
 	}
 
+	for _, key := range []string{"initializer", "initialization_timeout", "instance_concurrency", "ca_port", "custom_container_config", "layers"} {
+		if d.HasChange(key) {
+			update = true
+			d.SetPartial(key)
+		}
+	}
+
 	if !d.IsNewResource() && update {
-		if _, err := client.fcconn.UpdateFunction(updateFunctionInput); err != nil {
+		// The function is updated by common request, as the runtime settings are not supported by the fc-go-sdk.
+		request := NewFcCommonRequest("/services/%s/functions/%s", parameters[0], parameters[1])
+		request.Payload = struct {
+			fc.FunctionUpdateObject
+			FcFunctionRuntimeConfig
+		}{updateFunctionInput.FunctionUpdateObject, buildFcFunctionRuntimeConfig(d, true)}
+		if err := client.ProcessFcCommonRequest(http.MethodPut, request, nil); err != nil {
 			return fmt.Errorf("Updating function of function compute got an error: %#v", err)
 		}
 	}
@@ -0,0 +1,120 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcProvisionedConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcProvisionedConfigCreate,
+		Read:   resourceAlicloudFcProvisionedConfigRead,
+		Update: resourceAlicloudFcProvisionedConfigUpdate,
+		Delete: resourceAlicloudFcProvisionedConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"qualifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(1, 10000),
+			},
+			"current": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcProvisionedConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	serviceName := d.Get("service_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	functionName := d.Get("function_name").(string)
+
+	if err := putFcProvisionConfig(meta.(*AliyunClient), serviceName, qualifier, functionName, d.Get("target").(int)); err != nil {
+		return fmt.Errorf("Creating provision config of function compute got an error: %#v", err)
+	}
+
+	d.SetId(serviceName + COMMA_SEPARATED + qualifier + COMMA_SEPARATED + functionName)
+
+	return resourceAlicloudFcProvisionedConfigRead(d, meta)
+}
+
+func resourceAlicloudFcProvisionedConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+	config, err := client.DescribeFcProvisionConfig(parameters[0], parameters[1], parameters[2])
+
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	// The provision config is released when its target is 0.
+	if config.Target == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("service_name", parameters[0])
+	d.Set("qualifier", parameters[1])
+	d.Set("function_name", parameters[2])
+	d.Set("target", config.Target)
+	d.Set("current", config.Current)
+
+	return nil
+}
+
+func resourceAlicloudFcProvisionedConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	if d.HasChange("target") {
+		if err := putFcProvisionConfig(meta.(*AliyunClient), parameters[0], parameters[1], parameters[2], d.Get("target").(int)); err != nil {
+			return fmt.Errorf("Updating provision config of function compute got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudFcProvisionedConfigRead(d, meta)
+}
+
+func resourceAlicloudFcProvisionedConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	if err := putFcProvisionConfig(meta.(*AliyunClient), parameters[0], parameters[1], parameters[2], 0); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Deleting provision config of function compute got an error: %#v", err)
+	}
+	return nil
+}
+
+func putFcProvisionConfig(client *AliyunClient, serviceName, qualifier, functionName string, target int) error {
+	request := NewFcCommonRequest("/services/%s.%s/functions/%s/provision-config", serviceName, qualifier, functionName)
+	request.Payload = &FcProvisionConfig{Target: target}
+
+	return client.ProcessFcCommonRequest(http.MethodPut, request, nil)
+}
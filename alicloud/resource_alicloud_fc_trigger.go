@@ -3,6 +3,7 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -49,6 +50,19 @@ type TriggerConfigHttp struct {
 	Methods  []string `json:"methods"`
 }
 
+type TriggerConfigMnsTopic struct {
+	NotifyContentFormat string `json:"notifyContentFormat"`
+	NotifyStrategy      string `json:"notifyStrategy"`
+	FilterTag           string `json:"filterTag,omitempty"`
+}
+
+type TriggerConfigCdnEvents struct {
+	EventName    string              `json:"eventName"`
+	EventVersion string              `json:"eventVersion"`
+	Notes        string              `json:"notes"`
+	Filter       map[string][]string `json:"filter"`
+}
+
 func resourceAlicloudFcTrigger() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAlicloudFcTriggerCreate,
@@ -83,74 +97,266 @@ func resourceAlicloudFcTrigger() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: validateAllowedStringValue([]string{
 					string(fc.TRIGGER_TYPE_OSS), string(fc.TRIGGER_TYPE_LOG), string(fc.TRIGGER_TYPE_TIMER),
-					string(fc.TRIGGER_TYPE_HTTP),
+					string(fc.TRIGGER_TYPE_HTTP), TriggerTypeMnsTopic, TriggerTypeCdnEvents,
 				}),
 			},
 			"invocation_role": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"qualifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			// Comm config
 			"config_enable": &schema.Schema{
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Deprecated: "Field 'config_enable' has been deprecated from provider version 1.9.4. New field 'enable' in the trigger blocks replaces it.",
 			},
 			// TIMER trigger config
 			"config_payload": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_payload' has been deprecated from provider version 1.9.4. New block 'timer' replaces it.",
 			},
 			"config_cron_expression": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_cron_expression' has been deprecated from provider version 1.9.4. New block 'timer' replaces it.",
 			},
 			// OSS trigger config
 			"config_events": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_events' has been deprecated from provider version 1.9.4. New block 'oss' replaces it.",
 			},
 			"config_filter_key_prefix": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_filter_key_prefix' has been deprecated from provider version 1.9.4. New block 'oss' replaces it.",
 			},
 			"config_filter_key_suffix": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_filter_key_suffix' has been deprecated from provider version 1.9.4. New block 'oss' replaces it.",
 			},
 			// LOG trigger config
 			"config_source_logstore": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_source_logstore' has been deprecated from provider version 1.9.4. New block 'log' replaces it.",
 			},
 			"config_job_interval": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Deprecated: "Field 'config_job_interval' has been deprecated from provider version 1.9.4. New block 'log' replaces it.",
 			},
 			"config_job_max_retry_time": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Deprecated: "Field 'config_job_max_retry_time' has been deprecated from provider version 1.9.4. New block 'log' replaces it.",
 			},
 			"config_function_parameter": &schema.Schema{
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:       schema.TypeMap,
+				Optional:   true,
+				Deprecated: "Field 'config_function_parameter' has been deprecated from provider version 1.9.4. New block 'log' replaces it.",
 			},
 			"config_log_project": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_log_project' has been deprecated from provider version 1.9.4. New block 'log' replaces it.",
 			},
 			"config_log_logstore": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_log_logstore' has been deprecated from provider version 1.9.4. New block 'log' replaces it.",
 			},
 			// HTTP trigger config
 			"config_auth_type": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validateAllowedStringValue([]string{"anonymous", "function"}),
+				Deprecated:   "Field 'config_auth_type' has been deprecated from provider version 1.9.4. New block 'http' replaces it.",
 			},
 			"config_methods": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field 'config_methods' has been deprecated from provider version 1.9.4. New block 'http' replaces it.",
+			},
+			// Typed trigger config, the block name is the same as the trigger type
+			"timer": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"oss", "log", "http", "mns_topic", "cdn_events"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron_expression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"payload": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"oss": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"timer", "log", "http", "mns_topic", "cdn_events"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"filter_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_key_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"log": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"timer", "oss", "http", "mns_topic", "cdn_events"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"job_interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(3, 600),
+						},
+						"job_max_retry_time": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateIntegerInRange(0, 100),
+						},
+						"function_parameter": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"log_project": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"http": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"timer", "oss", "log", "mns_topic", "cdn_events"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"anonymous", "function"}),
+						},
+						"methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAllowedStringValue([]string{"GET", "POST", "PUT", "DELETE", "HEAD", "PATCH"}),
+							},
+						},
+					},
+				},
+			},
+			"mns_topic": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"timer", "oss", "log", "http", "cdn_events"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notify_content_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "STREAM",
+							ValidateFunc: validateAllowedStringValue([]string{"STREAM", "JSON"}),
+						},
+						"notify_strategy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "BACKOFF_RETRY",
+							ValidateFunc: validateAllowedStringValue([]string{"BACKOFF_RETRY", "EXPONENTIAL_DECAY_RETRY"}),
+						},
+						"filter_tag": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"cdn_events": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"timer", "oss", "log", "http", "mns_topic"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"event_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
 			},
 			// Computed values
 			"alicloud_fc_trigger": {
@@ -215,9 +421,17 @@ func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) e
 	createTriggerInput.WithTriggerName(triggerName)
 	createTriggerInput.WithTriggerType(d.Get("trigger_type").(string))
 
+	typedConfig, typed, err := buildFcTypedTriggerConfig(d)
+	if err != nil {
+		return fmt.Errorf("Creating trigger of function compute got an error: %#v", err)
+	}
+
 	triggerConfig := make(map[string]interface{})
 	switch d.Get("trigger_type").(string) {
 	case string(fc.TRIGGER_TYPE_TIMER):
+		if typed {
+			break
+		}
 		if enable, ok := d.GetOk("config_enable"); ok {
 			triggerConfig["enable"] = enable
 		} else {
@@ -236,6 +450,9 @@ func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) e
 	case string(fc.TRIGGER_TYPE_OSS):
 		createTriggerInput.WithSourceARN(d.Get("source_arn").(string))
 		createTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
+		if typed {
+			break
+		}
 		if events, ok := d.GetOk("config_events"); ok {
 			triggerConfig["events"] = strings.Split(events.(string), COMMA_SEPARATED)
 		} else {
@@ -259,6 +476,9 @@ func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) e
 	case string(fc.TRIGGER_TYPE_LOG):
 		createTriggerInput.WithSourceARN(d.Get("source_arn").(string))
 		createTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
+		if typed {
+			break
+		}
 		sourceConfig := make(map[string]string)
 		jobConfig := make(map[string]int32)
 		logConfig := make(map[string]string)
@@ -307,6 +527,9 @@ func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) e
 		triggerConfig["jobConfig"] = jobConfig
 		triggerConfig["logConfig"] = logConfig
 	case string(fc.TRIGGER_TYPE_HTTP):
+		if typed {
+			break
+		}
 		if authType, ok := d.GetOk("config_auth_type"); ok {
 			triggerConfig["authType"] = authType
 		} else {
@@ -317,12 +540,29 @@ func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) e
 		} else {
 			return fmt.Errorf("Creating trigger of function compute got an error: %#v", "Can not find the parameter config_methods")
 		}
+	case TriggerTypeMnsTopic, TriggerTypeCdnEvents:
+		createTriggerInput.WithSourceARN(d.Get("source_arn").(string))
+		createTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
 	default:
 	}
-	createTriggerInput.WithTriggerConfig(triggerConfig)
+	if typed {
+		createTriggerInput.WithTriggerConfig(typedConfig)
+	} else {
+		createTriggerInput.WithTriggerConfig(triggerConfig)
+	}
 
-	_, err := client.fcconn.CreateTrigger(createTriggerInput)
-	if err != nil {
+	if qualifier, ok := d.GetOk("qualifier"); ok {
+		// The fc-go-sdk does not support the qualifier yet.
+		request := NewFcCommonRequest("/services/%s/functions/%s/triggers", serviceName, functionName)
+		request.Payload = struct {
+			fc.TriggerCreateObject
+			Qualifier string `json:"qualifier"`
+		}{createTriggerInput.TriggerCreateObject, qualifier.(string)}
+
+		if err := client.ProcessFcCommonRequest(http.MethodPost, request, nil); err != nil {
+			return fmt.Errorf("Creating trigger of function compute got an error: %#v", err)
+		}
+	} else if _, err := client.fcconn.CreateTrigger(createTriggerInput); err != nil {
 		return fmt.Errorf("Creating trigger of function compute got an error: %#v", err)
 	}
 
@@ -334,7 +574,7 @@ func resourceAlicloudFcTriggerCreate(d *schema.ResourceData, meta interface{}) e
 func resourceAlicloudFcTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
-	getTriggerOutput, err := client.fcconn.GetTrigger(fc.NewGetTriggerInput(parameters[0], parameters[1], parameters[2]))
+	getTriggerOutput, err := client.DescribeFcTrigger(parameters[0], parameters[1], parameters[2])
 
 	if err != nil {
 		if NotFoundError(err) {
@@ -348,68 +588,73 @@ func resourceAlicloudFcTriggerRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("function_name", parameters[1])
 	d.Set("trigger_name", parameters[2])
 	d.Set("trigger_type", getTriggerOutput.TriggerType)
+	d.Set("qualifier", getTriggerOutput.Qualifier)
 
-	switch d.Get("trigger_type").(string) {
-	case string(fc.TRIGGER_TYPE_TIMER):
-		var triggerconfigTimer TriggerConfigTimer
+	d.Set("source_arn", getTriggerOutput.SourceARN)
+	d.Set("invocation_role", getTriggerOutput.InvocationRole)
 
-		if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerconfigTimer); err != nil {
-			return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
-		} else {
-			d.Set("config_payload", triggerconfigTimer.Payload)
-			d.Set("config_cron_expression", triggerconfigTimer.CronExpression)
-			d.Set("enable", triggerconfigTimer.Enable)
-		}
-	case string(fc.TRIGGER_TYPE_OSS):
-		d.Set("source_arn", getTriggerOutput.SourceARN)
-		d.Set("invocation_role", getTriggerOutput.InvocationRole)
+	if err := setFcTypedTriggerConfig(d, getTriggerOutput.TriggerType, getTriggerOutput.RawTriggerConfig); err != nil {
+		return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
+	}
 
-		var triggerConfigOss TriggerConfigOss
+	// The legacy config fields are only set when they are still in use.
+	if fcLegacyTriggerConfigUsed(d) {
+		switch d.Get("trigger_type").(string) {
+		case string(fc.TRIGGER_TYPE_TIMER):
+			var triggerconfigTimer TriggerConfigTimer
 
-		if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerConfigOss); err != nil {
-			return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
-		} else {
-			d.Set("config_events", strings.Join(triggerConfigOss.Events, COMMA_SEPARATED))
-			d.Set("config_filter_key_prefix", triggerConfigOss.Filter.Key.Prefix)
-			d.Set("config_filter_key_suffix", triggerConfigOss.Filter.Key.Suffix)
-		}
-	case string(fc.TRIGGER_TYPE_LOG):
-		d.Set("source_arn", getTriggerOutput.SourceARN)
-		d.Set("invocation_role", getTriggerOutput.InvocationRole)
+			if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerconfigTimer); err != nil {
+				return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
+			} else {
+				d.Set("config_payload", triggerconfigTimer.Payload)
+				d.Set("config_cron_expression", triggerconfigTimer.CronExpression)
+				d.Set("enable", triggerconfigTimer.Enable)
+			}
+		case string(fc.TRIGGER_TYPE_OSS):
+			var triggerConfigOss TriggerConfigOss
 
-		var triggerConfigLog TriggerConfigLog
+			if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerConfigOss); err != nil {
+				return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
+			} else {
+				d.Set("config_events", strings.Join(triggerConfigOss.Events, COMMA_SEPARATED))
+				d.Set("config_filter_key_prefix", triggerConfigOss.Filter.Key.Prefix)
+				d.Set("config_filter_key_suffix", triggerConfigOss.Filter.Key.Suffix)
+			}
+		case string(fc.TRIGGER_TYPE_LOG):
+			var triggerConfigLog TriggerConfigLog
 
-		if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerConfigLog); err != nil {
-			return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
-		} else {
-			d.Set("config_source_logstore", triggerConfigLog.SourceConfig.Logstore)
-			d.Set("config_job_interval", triggerConfigLog.JobConfig.TriggerInterval)
-			d.Set("config_job_max_retry_time", triggerConfigLog.JobConfig.MaxRetryTime)
-			d.Set("functionParameter", triggerConfigLog.FunctionParameter)
-			d.Set("config_log_project", triggerConfigLog.LogConfig.Project)
-			d.Set("config_log_logstore", triggerConfigLog.LogConfig.Logstore)
-		}
-	case string(fc.TRIGGER_TYPE_HTTP):
-		var triggerConfigHttp TriggerConfigHttp
+			if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerConfigLog); err != nil {
+				return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
+			} else {
+				d.Set("config_source_logstore", triggerConfigLog.SourceConfig.Logstore)
+				d.Set("config_job_interval", triggerConfigLog.JobConfig.TriggerInterval)
+				d.Set("config_job_max_retry_time", triggerConfigLog.JobConfig.MaxRetryTime)
+				d.Set("functionParameter", triggerConfigLog.FunctionParameter)
+				d.Set("config_log_project", triggerConfigLog.LogConfig.Project)
+				d.Set("config_log_logstore", triggerConfigLog.LogConfig.Logstore)
+			}
+		case string(fc.TRIGGER_TYPE_HTTP):
+			var triggerConfigHttp TriggerConfigHttp
 
-		if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerConfigHttp); err != nil {
-			return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
-		} else {
-			d.Set("config_auth_type", triggerConfigHttp.AuthType)
-			d.Set("config_methods", strings.Join(triggerConfigHttp.Methods, COMMA_SEPARATED))
+			if err := json.Unmarshal(getTriggerOutput.RawTriggerConfig, &triggerConfigHttp); err != nil {
+				return fmt.Errorf("Reading trigger of function compute got an error: %#v", err)
+			} else {
+				d.Set("config_auth_type", triggerConfigHttp.AuthType)
+				d.Set("config_methods", strings.Join(triggerConfigHttp.Methods, COMMA_SEPARATED))
+			}
+		default:
 		}
-	default:
 	}
 
 	var s []map[string]interface{}
 	mapping := map[string]interface{}{
-		"id":                 *getTriggerOutput.TriggerName,
-		"name":               *getTriggerOutput.TriggerName,
+		"id":                 getTriggerOutput.TriggerName,
+		"name":               getTriggerOutput.TriggerName,
 		"status":             "Available",
-		"creation_time":      *getTriggerOutput.CreatedTime,
-		"trigger_type":       *getTriggerOutput.TriggerType,
+		"creation_time":      getTriggerOutput.CreatedTime,
+		"trigger_type":       getTriggerOutput.TriggerType,
 		"raw_rrigger_config": string(getTriggerOutput.RawTriggerConfig[:]),
-		"last_modified_time": *getTriggerOutput.LastModifiedTime,
+		"last_modified_time": getTriggerOutput.LastModifiedTime,
 		"resource_type":      "alicloud_fc_trigger",
 	}
 	s = append(s, mapping)
@@ -424,6 +669,10 @@ func resourceAlicloudFcTriggerUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*AliyunClient)
 	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
 
+	if err := checkFcTriggerConfigBlocks(d); err != nil {
+		return fmt.Errorf("Updating trigger of function compute got an error: %#v", err)
+	}
+
 	d.Partial(true)
 	update := false
 	configChange := false
@@ -436,170 +685,183 @@ func resourceAlicloudFcTriggerUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("invocation_role")
 	}
 
-	triggerConfig := make(map[string]interface{})
-	triggerConfig["enable"] = d.Get("config_enable")
-	if d.HasChange("config_enable") {
-		update = true
-		configChange = true
-		d.SetPartial("config_enable")
-	}
-	if d.HasChange("config_function_parameter") {
-		update = true
-		configChange = true
-		triggerConfig["functionParameter"] = d.Get("config_function_parameter")
-		d.SetPartial("config_function_parameter")
-	}
-	switch d.Get("trigger_type").(string) {
-	case string(fc.TRIGGER_TYPE_TIMER):
-		if configChange || d.HasChange("config_payload") || d.HasChange("config_cron_expression") {
-			update = true
-			configChange = true
-			if d.HasChange("config_payload") {
-				d.SetPartial("config_payload")
-			}
-			if d.HasChange("config_cron_expression") {
-				d.SetPartial("config_cron_expression")
-			}
-			if payload, ok := d.GetOk("config_payload"); ok {
-				triggerConfig["payload"] = payload
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_payload")
+	triggerType := d.Get("trigger_type").(string)
+	if !fcLegacyTriggerConfigUsed(d) {
+		if d.HasChange(triggerType) {
+			triggerConfig, _, err := buildFcTypedTriggerConfig(d)
+			if err != nil {
+				return fmt.Errorf("Updating trigger of function compute got an error: %#v", err)
 			}
-			if cronExpression, ok := d.GetOk("config_cron_expression"); ok {
-				triggerConfig["cronExpression"] = cronExpression
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_cron_expression")
-			}
-		}
-	case string(fc.TRIGGER_TYPE_OSS):
-		if d.HasChange("invocation_role") {
 			update = true
-			configChange = true
-			updateTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
-			d.SetPartial("invocation_role")
-		}
-		if configChange || d.HasChange("config_events") || d.HasChange("config_filter_key_prefix") || d.HasChange("config_filter_key_suffix") {
-			update = true
-			if d.HasChange("config_events") {
-				d.SetPartial("config_events")
-			}
-			if d.HasChange("config_filter_key_prefix") {
-				d.SetPartial("config_filter_key_prefix")
-			}
-			if d.HasChange("config_filter_key_suffix") {
-				d.SetPartial("config_filter_key_suffix")
-			}
-			if events, ok := d.GetOk("config_events"); ok {
-				triggerConfig["events"] = strings.Split(events.(string), COMMA_SEPARATED)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_events")
-			}
-
-			filter := make(map[string]interface{})
-			key := make(map[string]string)
-			if prefix, ok := d.GetOk("config_filter_key_prefix"); ok {
-				key["prefix"] = prefix.(string)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_filter_key_prefix")
-			}
-			if suffix, ok := d.GetOk("config_filter_key_suffix"); ok {
-				key["suffix"] = suffix.(string)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_filter_key_suffix")
-			}
-			filter["key"] = key
-			triggerConfig["filter"] = filter
+			updateTriggerInput.WithTriggerConfig(triggerConfig)
+			d.SetPartial(triggerType)
 		}
-	case string(fc.TRIGGER_TYPE_LOG):
-		if d.HasChange("invocation_role") {
+	} else {
+		triggerConfig := make(map[string]interface{})
+		triggerConfig["enable"] = d.Get("config_enable")
+		if d.HasChange("config_enable") {
 			update = true
-			updateTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
-			d.SetPartial("invocation_role")
+			configChange = true
+			d.SetPartial("config_enable")
 		}
-		if configChange || d.HasChange("config_source_logstore") || d.HasChange("config_job_interval") || d.HasChange("config_job_max_retry_time") || d.HasChange("config_log_project") || d.HasChange("config_log_logstore") {
+		if d.HasChange("config_function_parameter") {
 			update = true
 			configChange = true
-			if d.HasChange("config_source_logstore") {
-				d.SetPartial("config_source_logstore")
-			}
-			if d.HasChange("config_job_interval") {
-				d.SetPartial("config_job_interval")
-			}
-			if d.HasChange("config_job_max_retry_time") {
-				d.SetPartial("config_job_max_retry_time")
-			}
-			if d.HasChange("config_log_project") {
-				d.SetPartial("config_log_project")
-			}
-			if d.HasChange("config_log_logstore") {
-				d.SetPartial("config_log_logstore")
-			}
-			sourceConfig := make(map[string]string)
-			jobConfig := make(map[string]int32)
-			logConfig := make(map[string]string)
-			if sourceLogstore, ok := d.GetOk("config_source_logstore"); ok {
-				sourceConfig["logstore"] = sourceLogstore.(string)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_source_logstore")
-			}
-			if jobInterval, ok := d.GetOk("config_job_interval"); ok {
-				if jobInterval32, err := strconv.ParseInt(strconv.Itoa(jobInterval.(int)), 10, 32); err != nil {
-					return fmt.Errorf("Updating trigger of function compute got an error: %#v", err)
+			triggerConfig["functionParameter"] = d.Get("config_function_parameter")
+			d.SetPartial("config_function_parameter")
+		}
+		switch d.Get("trigger_type").(string) {
+		case string(fc.TRIGGER_TYPE_TIMER):
+			if configChange || d.HasChange("config_payload") || d.HasChange("config_cron_expression") {
+				update = true
+				configChange = true
+				if d.HasChange("config_payload") {
+					d.SetPartial("config_payload")
+				}
+				if d.HasChange("config_cron_expression") {
+					d.SetPartial("config_cron_expression")
+				}
+				if payload, ok := d.GetOk("config_payload"); ok {
+					triggerConfig["payload"] = payload
 				} else {
-					jobConfig["triggerInterval"] = int32(jobInterval32)
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_payload")
 				}
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_job_interval")
-			}
-			if maxRetryTime, ok := d.GetOk("config_job_max_retry_time"); ok {
-				if maxRetryTime32, err := strconv.ParseInt(strconv.Itoa(maxRetryTime.(int)), 10, 32); err != nil {
-					return fmt.Errorf("Updating trigger of function compute got an error: %#v", err)
+				if cronExpression, ok := d.GetOk("config_cron_expression"); ok {
+					triggerConfig["cronExpression"] = cronExpression
 				} else {
-					jobConfig["maxRetryTime"] = int32(maxRetryTime32)
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_cron_expression")
 				}
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_job_max_retry_time")
 			}
-			if project, ok := d.GetOk("config_log_project"); ok {
-				logConfig["project"] = project.(string)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_log_project")
+		case string(fc.TRIGGER_TYPE_OSS):
+			if d.HasChange("invocation_role") {
+				update = true
+				configChange = true
+				updateTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
+				d.SetPartial("invocation_role")
 			}
-			if logstore, ok := d.GetOk("config_log_logstore"); ok {
-				logConfig["logstore"] = logstore.(string)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_log_logstore")
-			}
-			triggerConfig["sourceConfig"] = sourceConfig
-			triggerConfig["jobConfig"] = jobConfig
-			triggerConfig["logConfig"] = logConfig
-		}
-	case string(fc.TRIGGER_TYPE_HTTP):
-		if configChange || d.HasChange("config_auth_type") || d.HasChange("config_methods") {
-			update = true
-			configChange = true
-			if d.HasChange("config_auth_type") {
-				d.SetPartial("config_auth_type")
+			if configChange || d.HasChange("config_events") || d.HasChange("config_filter_key_prefix") || d.HasChange("config_filter_key_suffix") {
+				update = true
+				if d.HasChange("config_events") {
+					d.SetPartial("config_events")
+				}
+				if d.HasChange("config_filter_key_prefix") {
+					d.SetPartial("config_filter_key_prefix")
+				}
+				if d.HasChange("config_filter_key_suffix") {
+					d.SetPartial("config_filter_key_suffix")
+				}
+				if events, ok := d.GetOk("config_events"); ok {
+					triggerConfig["events"] = strings.Split(events.(string), COMMA_SEPARATED)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_events")
+				}
+
+				filter := make(map[string]interface{})
+				key := make(map[string]string)
+				if prefix, ok := d.GetOk("config_filter_key_prefix"); ok {
+					key["prefix"] = prefix.(string)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_filter_key_prefix")
+				}
+				if suffix, ok := d.GetOk("config_filter_key_suffix"); ok {
+					key["suffix"] = suffix.(string)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_filter_key_suffix")
+				}
+				filter["key"] = key
+				triggerConfig["filter"] = filter
 			}
-			if d.HasChange("config_methods") {
-				d.SetPartial("config_methods")
+		case string(fc.TRIGGER_TYPE_LOG):
+			if d.HasChange("invocation_role") {
+				update = true
+				updateTriggerInput.WithInvocationRole(d.Get("invocation_role").(string))
+				d.SetPartial("invocation_role")
 			}
-			if authType, ok := d.GetOk("config_auth_type"); ok {
-				triggerConfig["authType"] = authType
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_auth_type")
+			if configChange || d.HasChange("config_source_logstore") || d.HasChange("config_job_interval") || d.HasChange("config_job_max_retry_time") || d.HasChange("config_log_project") || d.HasChange("config_log_logstore") {
+				update = true
+				configChange = true
+				if d.HasChange("config_source_logstore") {
+					d.SetPartial("config_source_logstore")
+				}
+				if d.HasChange("config_job_interval") {
+					d.SetPartial("config_job_interval")
+				}
+				if d.HasChange("config_job_max_retry_time") {
+					d.SetPartial("config_job_max_retry_time")
+				}
+				if d.HasChange("config_log_project") {
+					d.SetPartial("config_log_project")
+				}
+				if d.HasChange("config_log_logstore") {
+					d.SetPartial("config_log_logstore")
+				}
+				sourceConfig := make(map[string]string)
+				jobConfig := make(map[string]int32)
+				logConfig := make(map[string]string)
+				if sourceLogstore, ok := d.GetOk("config_source_logstore"); ok {
+					sourceConfig["logstore"] = sourceLogstore.(string)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_source_logstore")
+				}
+				if jobInterval, ok := d.GetOk("config_job_interval"); ok {
+					if jobInterval32, err := strconv.ParseInt(strconv.Itoa(jobInterval.(int)), 10, 32); err != nil {
+						return fmt.Errorf("Updating trigger of function compute got an error: %#v", err)
+					} else {
+						jobConfig["triggerInterval"] = int32(jobInterval32)
+					}
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_job_interval")
+				}
+				if maxRetryTime, ok := d.GetOk("config_job_max_retry_time"); ok {
+					if maxRetryTime32, err := strconv.ParseInt(strconv.Itoa(maxRetryTime.(int)), 10, 32); err != nil {
+						return fmt.Errorf("Updating trigger of function compute got an error: %#v", err)
+					} else {
+						jobConfig["maxRetryTime"] = int32(maxRetryTime32)
+					}
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_job_max_retry_time")
+				}
+				if project, ok := d.GetOk("config_log_project"); ok {
+					logConfig["project"] = project.(string)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_log_project")
+				}
+				if logstore, ok := d.GetOk("config_log_logstore"); ok {
+					logConfig["logstore"] = logstore.(string)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_log_logstore")
+				}
+				triggerConfig["sourceConfig"] = sourceConfig
+				triggerConfig["jobConfig"] = jobConfig
+				triggerConfig["logConfig"] = logConfig
 			}
-			if methods, ok := d.GetOk("config_methods"); ok {
-				triggerConfig["methods"] = strings.Split(methods.(string), COMMA_SEPARATED)
-			} else {
-				return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_methods")
+		case string(fc.TRIGGER_TYPE_HTTP):
+			if configChange || d.HasChange("config_auth_type") || d.HasChange("config_methods") {
+				update = true
+				configChange = true
+				if d.HasChange("config_auth_type") {
+					d.SetPartial("config_auth_type")
+				}
+				if d.HasChange("config_methods") {
+					d.SetPartial("config_methods")
+				}
+				if authType, ok := d.GetOk("config_auth_type"); ok {
+					triggerConfig["authType"] = authType
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_auth_type")
+				}
+				if methods, ok := d.GetOk("config_methods"); ok {
+					triggerConfig["methods"] = strings.Split(methods.(string), COMMA_SEPARATED)
+				} else {
+					return fmt.Errorf("Updating trigger of function compute got an error: %#v", "Can not find the parameter config_methods")
+				}
 			}
+		default:
 		}
-	default:
-	}
 
-	if configChange {
-		updateTriggerInput.WithTriggerConfig(triggerConfig)
+		if configChange {
+			updateTriggerInput.WithTriggerConfig(triggerConfig)
+		}
 	}
 	if !d.IsNewResource() && update {
 		if _, err := client.fcconn.UpdateTrigger(updateTriggerInput); err != nil {
@@ -623,17 +885,196 @@ func resourceAlicloudFcTriggerDelete(d *schema.ResourceData, meta interface{}) e
 			return resource.NonRetryableError(fmt.Errorf("Deleting trigger of function compute got an error: %#v", err))
 		}
 
-		resp, err := client.fcconn.GetTrigger(fc.NewGetTriggerInput(parameters[0], parameters[1], parameters[2]))
+		resp, err := client.DescribeFcTrigger(parameters[0], parameters[1], parameters[2])
 		if err != nil {
 			if NotFoundError(err) {
 				return nil
 			}
 			return resource.NonRetryableError(fmt.Errorf("trigger trigger of function compute got an error: %#v", err))
 		}
-		if resp.TriggerName == nil {
+		if resp.TriggerName == "" {
 			return nil
 		}
 
 		return resource.RetryableError(fmt.Errorf("Deleting trigger of function compute got an error: %#v", err))
 	})
 }
+
+// fcLegacyTriggerConfigUsed reports whether the deprecated config fields are used for the trigger type.
+func fcLegacyTriggerConfigUsed(d *schema.ResourceData) bool {
+	for _, key := range fcLegacyTriggerConfigKeys[d.Get("trigger_type").(string)] {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFcTriggerConfigBlocks checks that no config block of another trigger type is set.
+func checkFcTriggerConfigBlocks(d *schema.ResourceData) error {
+	triggerType := d.Get("trigger_type").(string)
+	for _, t := range []string{string(fc.TRIGGER_TYPE_TIMER), string(fc.TRIGGER_TYPE_OSS), string(fc.TRIGGER_TYPE_LOG),
+		string(fc.TRIGGER_TYPE_HTTP), TriggerTypeMnsTopic, TriggerTypeCdnEvents} {
+		if v, ok := d.GetOk(t); ok && t != triggerType && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("The block '%s' can not be used when 'trigger_type' is '%s'.", t, triggerType)
+		}
+	}
+	return nil
+}
+
+// buildFcTypedTriggerConfig builds the trigger config from the block named as the trigger type.
+// It returns false if the deprecated config fields are used instead.
+func buildFcTypedTriggerConfig(d *schema.ResourceData) (interface{}, bool, error) {
+	triggerType := d.Get("trigger_type").(string)
+	if err := checkFcTriggerConfigBlocks(d); err != nil {
+		return nil, false, err
+	}
+
+	v, ok := d.GetOk(triggerType)
+	if fcLegacyTriggerConfigUsed(d) {
+		if ok && d.IsNewResource() {
+			return nil, false, fmt.Errorf("The block '%s' can not be used together with the deprecated 'config_*' fields.", triggerType)
+		}
+		return nil, false, nil
+	}
+	if !ok || len(v.([]interface{})) < 1 {
+		return nil, false, fmt.Errorf("The block '%s' is required when 'trigger_type' is '%s'.", triggerType, triggerType)
+	}
+
+	config := v.([]interface{})[0].(map[string]interface{})
+	switch triggerType {
+	case string(fc.TRIGGER_TYPE_TIMER):
+		return map[string]interface{}{
+			"cronExpression": config["cron_expression"],
+			"payload":        config["payload"],
+			"enable":         config["enable"],
+		}, true, nil
+	case string(fc.TRIGGER_TYPE_OSS):
+		return map[string]interface{}{
+			"events": expandStringList(config["events"].([]interface{})),
+			"filter": map[string]interface{}{
+				"key": map[string]string{
+					"prefix": config["filter_key_prefix"].(string),
+					"suffix": config["filter_key_suffix"].(string),
+				},
+			},
+		}, true, nil
+	case string(fc.TRIGGER_TYPE_LOG):
+		return map[string]interface{}{
+			"sourceConfig": map[string]string{
+				"logstore": config["source_logstore"].(string),
+			},
+			"jobConfig": map[string]int{
+				"triggerInterval": config["job_interval"].(int),
+				"maxRetryTime":    config["job_max_retry_time"].(int),
+			},
+			"functionParameter": config["function_parameter"],
+			"logConfig": map[string]string{
+				"project":  config["log_project"].(string),
+				"logstore": config["log_logstore"].(string),
+			},
+			"enable": config["enable"],
+		}, true, nil
+	case string(fc.TRIGGER_TYPE_HTTP):
+		return TriggerConfigHttp{
+			AuthType: config["auth_type"].(string),
+			Methods:  expandStringList(config["methods"].([]interface{})),
+		}, true, nil
+	case TriggerTypeMnsTopic:
+		return TriggerConfigMnsTopic{
+			NotifyContentFormat: config["notify_content_format"].(string),
+			NotifyStrategy:      config["notify_strategy"].(string),
+			FilterTag:           config["filter_tag"].(string),
+		}, true, nil
+	case TriggerTypeCdnEvents:
+		return TriggerConfigCdnEvents{
+			EventName:    config["event_name"].(string),
+			EventVersion: config["event_version"].(string),
+			Notes:        config["notes"].(string),
+			Filter: map[string][]string{
+				"domain": expandStringList(config["domains"].([]interface{})),
+			},
+		}, true, nil
+	}
+	return nil, false, fmt.Errorf("The trigger type %s is not supported.", triggerType)
+}
+
+// setFcTypedTriggerConfig sets the block named as the trigger type from the raw trigger config.
+func setFcTypedTriggerConfig(d *schema.ResourceData, triggerType string, raw []byte) error {
+	var config map[string]interface{}
+	switch triggerType {
+	case string(fc.TRIGGER_TYPE_TIMER):
+		var timer TriggerConfigTimer
+		if err := json.Unmarshal(raw, &timer); err != nil {
+			return err
+		}
+		config = map[string]interface{}{
+			"cron_expression": timer.CronExpression,
+			"payload":         timer.Payload,
+			"enable":          timer.Enable,
+		}
+	case string(fc.TRIGGER_TYPE_OSS):
+		var oss TriggerConfigOss
+		if err := json.Unmarshal(raw, &oss); err != nil {
+			return err
+		}
+		config = map[string]interface{}{
+			"events":            oss.Events,
+			"filter_key_prefix": oss.Filter.Key.Prefix,
+			"filter_key_suffix": oss.Filter.Key.Suffix,
+		}
+	case string(fc.TRIGGER_TYPE_LOG):
+		var log TriggerConfigLog
+		if err := json.Unmarshal(raw, &log); err != nil {
+			return err
+		}
+		parameter := make(map[string]interface{})
+		if log.FunctionParameter != nil {
+			for k, v := range *log.FunctionParameter {
+				parameter[k] = fmt.Sprint(v)
+			}
+		}
+		config = map[string]interface{}{
+			"source_logstore":    log.SourceConfig.Logstore,
+			"job_interval":       log.JobConfig.TriggerInterval,
+			"job_max_retry_time": log.JobConfig.MaxRetryTime,
+			"function_parameter": parameter,
+			"log_project":        log.LogConfig.Project,
+			"log_logstore":       log.LogConfig.Logstore,
+			"enable":             log.Enable,
+		}
+	case string(fc.TRIGGER_TYPE_HTTP):
+		var httpConfig TriggerConfigHttp
+		if err := json.Unmarshal(raw, &httpConfig); err != nil {
+			return err
+		}
+		config = map[string]interface{}{
+			"auth_type": httpConfig.AuthType,
+			"methods":   httpConfig.Methods,
+		}
+	case TriggerTypeMnsTopic:
+		var mns TriggerConfigMnsTopic
+		if err := json.Unmarshal(raw, &mns); err != nil {
+			return err
+		}
+		config = map[string]interface{}{
+			"notify_content_format": mns.NotifyContentFormat,
+			"notify_strategy":       mns.NotifyStrategy,
+			"filter_tag":            mns.FilterTag,
+		}
+	case TriggerTypeCdnEvents:
+		var cdn TriggerConfigCdnEvents
+		if err := json.Unmarshal(raw, &cdn); err != nil {
+			return err
+		}
+		config = map[string]interface{}{
+			"event_name":    cdn.EventName,
+			"event_version": cdn.EventVersion,
+			"notes":         cdn.Notes,
+			"domains":       cdn.Filter["domain"],
+		}
+	default:
+		return nil
+	}
+	return d.Set(triggerType, []map[string]interface{}{config})
+}
@@ -0,0 +1,95 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudFcVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudFcVersionCreate,
+		Read:   resourceAlicloudFcVersionRead,
+		Delete: resourceAlicloudFcVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"version_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudFcVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	serviceName := d.Get("service_name").(string)
+
+	request := NewFcCommonRequest("/services/%s/versions", serviceName)
+	request.Payload = map[string]string{
+		"description": d.Get("description").(string),
+	}
+
+	var version FcVersion
+	if err := client.ProcessFcCommonRequest(http.MethodPost, request, &version); err != nil {
+		return fmt.Errorf("Publishing version of function compute got an error: %#v", err)
+	}
+
+	d.SetId(serviceName + COMMA_SEPARATED + version.VersionId)
+
+	return resourceAlicloudFcVersionRead(d, meta)
+}
+
+func resourceAlicloudFcVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+	version, err := client.DescribeFcVersion(parameters[0], parameters[1])
+
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("service_name", parameters[0])
+	d.Set("version_id", version.VersionId)
+	d.Set("description", version.Description)
+	d.Set("creation_time", version.CreatedTime)
+
+	return nil
+}
+
+func resourceAlicloudFcVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	request := NewFcCommonRequest("/services/%s/versions/%s", parameters[0], parameters[1])
+	if err := client.ProcessFcCommonRequest(http.MethodDelete, request, nil); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("Deleting version of function compute got an error: %#v", err)
+	}
+	return nil
+}
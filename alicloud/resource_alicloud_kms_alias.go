@@ -0,0 +1,98 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudKmsAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKmsAliasCreate,
+		Read:   resourceAlicloudKmsAliasRead,
+		Update: resourceAlicloudKmsAliasUpdate,
+		Delete: resourceAlicloudKmsAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKmsAliasName,
+			},
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).alikmsconn
+
+	args := kms.CreateCreateAliasRequest()
+	args.AliasName = d.Get("alias_name").(string)
+	args.KeyId = d.Get("key_id").(string)
+
+	if _, err := conn.CreateAlias(args); err != nil {
+		return fmt.Errorf("CreateAlias got an error: %#v.", err)
+	}
+
+	d.SetId(args.AliasName)
+
+	return resourceAlicloudKmsAliasRead(d, meta)
+}
+
+func resourceAlicloudKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
+	alias, err := meta.(*AliyunClient).DescribeKmsAlias(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("ListAliases got an error: %#v.", err)
+	}
+
+	d.Set("alias_name", alias.AliasName)
+	d.Set("key_id", alias.KeyId)
+
+	return nil
+}
+
+func resourceAlicloudKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).alikmsconn
+
+	if d.HasChange("key_id") {
+		args := kms.CreateUpdateAliasRequest()
+		args.AliasName = d.Id()
+		args.KeyId = d.Get("key_id").(string)
+
+		if _, err := conn.UpdateAlias(args); err != nil {
+			return fmt.Errorf("UpdateAlias got an error: %#v.", err)
+		}
+	}
+
+	return resourceAlicloudKmsAliasRead(d, meta)
+}
+
+func resourceAlicloudKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).alikmsconn
+
+	args := kms.CreateDeleteAliasRequest()
+	args.AliasName = d.Id()
+
+	if _, err := conn.DeleteAlias(args); err != nil {
+		if IsExceptedError(err, ForbiddenAliasNotFound) {
+			return nil
+		}
+		return fmt.Errorf("DeleteAlias got an error: %#v.", err)
+	}
+
+	return nil
+}
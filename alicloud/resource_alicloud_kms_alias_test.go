@@ -0,0 +1,102 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAlicloudKmsAlias_basic(t *testing.T) {
+	var alias kms.Alias
+	rand := acctest.RandIntRange(10000, 999999)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudKmsAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudKmsAliasBasic(rand, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudKmsAliasExists("alicloud_kms_alias.alias", &alias),
+					resource.TestCheckResourceAttr("alicloud_kms_alias.alias", "alias_name", fmt.Sprintf("alias/tf-testacc-%d", rand)),
+					resource.TestCheckResourceAttrPair("alicloud_kms_alias.alias", "key_id", "alicloud_kms_key.first", "id"),
+				),
+			},
+			{
+				Config: testAlicloudKmsAliasBasic(rand, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudKmsAliasExists("alicloud_kms_alias.alias", &alias),
+					resource.TestCheckResourceAttrPair("alicloud_kms_alias.alias", "key_id", "alicloud_kms_key.second", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlicloudKmsAliasExists(name string, alias *kms.Alias) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Alias ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AliyunClient)
+
+		o, err := client.DescribeKmsAlias(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*alias = o
+		return nil
+	}
+}
+
+func testAccCheckAlicloudKmsAliasDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AliyunClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "alicloud_kms_alias" {
+			continue
+		}
+
+		if _, err := client.DescribeKmsAlias(rs.Primary.ID); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("KMS alias %s still exists.", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAlicloudKmsAliasBasic(rand int, key string) string {
+	return fmt.Sprintf(`
+resource "alicloud_kms_key" "first" {
+    description = "Terraform acc test kms alias"
+    deletion_window_in_days = 7
+}
+
+resource "alicloud_kms_key" "second" {
+    description = "Terraform acc test kms alias"
+    deletion_window_in_days = 7
+}
+
+resource "alicloud_kms_alias" "alias" {
+    alias_name = "alias/tf-testacc-%d"
+    key_id = "${alicloud_kms_key.%s.id}"
+}
+`, rand, key)
+}
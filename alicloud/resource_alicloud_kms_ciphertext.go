@@ -0,0 +1,79 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudKmsCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudKmsCiphertextCreate,
+		Read:   resourceAlicloudKmsCiphertextRead,
+		Delete: resourceAlicloudKmsCiphertextDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plaintext": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validateStringLengthInRange(1, 4096),
+			},
+			"encryption_context": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     schema.TypeString,
+			},
+			"ciphertext_blob": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudKmsCiphertextCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AliyunClient).alikmsconn
+
+	args := kms.CreateEncryptRequest()
+	args.KeyId = d.Get("key_id").(string)
+	args.Plaintext = d.Get("plaintext").(string)
+
+	if v, ok := d.GetOk("encryption_context"); ok {
+		context, err := convertKmsEncryptionContext(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		args.EncryptionContext = context
+	}
+
+	resp, err := conn.Encrypt(args)
+	if err != nil {
+		return fmt.Errorf("Encrypt got an error: %#v.", err)
+	}
+
+	// The ciphertext is different every time, so a random ID is used for the resource.
+	d.SetId(resource.UniqueId())
+	d.Set("ciphertext_blob", resp.CiphertextBlob)
+
+	return resourceAlicloudKmsCiphertextRead(d, meta)
+}
+
+func resourceAlicloudKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	// The ciphertext blob can not be read back from KMS, keep the state as it is.
+	return nil
+}
+
+func resourceAlicloudKmsCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
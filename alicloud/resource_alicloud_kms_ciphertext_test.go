@@ -0,0 +1,81 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudKmsCiphertext_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudKmsCiphertextBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("alicloud_kms_ciphertext.default", "ciphertext_blob"),
+					resource.TestCheckResourceAttrPair("data.alicloud_kms_ciphertext.default", "key_id",
+						"alicloud_kms_key.key", "id"),
+					resource.TestCheckResourceAttr("data.alicloud_kms_ciphertext.default", "plaintext", "plaintext"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudKmsCiphertext_encryptionContext(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudKmsCiphertextEncryptionContext,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("alicloud_kms_ciphertext.default", "ciphertext_blob"),
+					resource.TestCheckResourceAttr("data.alicloud_kms_ciphertext.default", "plaintext", "plaintext"),
+				),
+			},
+		},
+	})
+}
+
+const testAlicloudKmsCiphertextBasic = `
+resource "alicloud_kms_key" "key" {
+    description = "Terraform acc test kms ciphertext"
+    deletion_window_in_days = 7
+}
+
+resource "alicloud_kms_ciphertext" "default" {
+	key_id = "${alicloud_kms_key.key.id}"
+	plaintext = "plaintext"
+}
+
+data "alicloud_kms_ciphertext" "default" {
+	ciphertext_blob = "${alicloud_kms_ciphertext.default.ciphertext_blob}"
+}
+`
+
+const testAlicloudKmsCiphertextEncryptionContext = `
+resource "alicloud_kms_key" "key" {
+    description = "Terraform acc test kms ciphertext"
+    deletion_window_in_days = 7
+}
+
+resource "alicloud_kms_ciphertext" "default" {
+	key_id = "${alicloud_kms_key.key.id}"
+	plaintext = "plaintext"
+	encryption_context = {
+		name = "value"
+	}
+}
+
+data "alicloud_kms_ciphertext" "default" {
+	ciphertext_blob = "${alicloud_kms_ciphertext.default.ciphertext_blob}"
+	encryption_context = {
+		name = "value"
+	}
+}
+`
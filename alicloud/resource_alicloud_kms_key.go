@@ -53,10 +53,34 @@ func resourceAlicloudKmsKey() *schema.Resource {
 				ValidateFunc: validateIntegerInRange(7, 30),
 				Default:      30,
 			},
+			"automatic_rotation": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      KmsRotationDisabled,
+				ValidateFunc: validateAllowedStringValue([]string{KmsRotationEnabled, KmsRotationDisabled}),
+			},
+			"rotation_interval": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateKmsRotationInterval,
+				DiffSuppressFunc: kmsRotationIntervalDiffSuppressFunc,
+			},
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -82,27 +106,32 @@ func resourceAlicloudKmsKeyCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAlicloudKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AliyunClient).kmsconn
-
-	key, err := conn.DescribeKey(d.Id())
+	key, err := meta.(*AliyunClient).DescribeKmsKey(d.Id())
 	if err != nil {
-		if IsExceptedError(err, ForbiddenKeyNotFound) {
+		if IsExceptedError(err, ForbiddenKeyNotFound) || NotFoundError(err) {
+			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("DescribeKey got an error: %#v.", err)
 	}
 
-	if KeyState(key.KeyMetadata.KeyState) == PendingDeletion {
-		log.Printf("[WARN] Removing KMS key %s because it's already gone", d.Id())
-		d.SetId("")
-		return nil
+	d.Set("description", key.Description)
+	d.Set("key_usage", key.KeyUsage)
+	// The key pending deletion is kept in the state to expose its key_state and delete_date.
+	if KeyState(key.KeyState) == PendingDeletion {
+		log.Printf("[WARN] KMS key %s is pending deletion and it will be deleted on %s.", d.Id(), key.DeleteDate)
+	} else {
+		d.Set("is_enabled", KeyState(key.KeyState) == Enabled)
 	}
-
-	d.Set("description", key.KeyMetadata.Description)
-	d.Set("key_usage", key.KeyMetadata.KeyUsage)
-	d.Set("is_enabled", KeyState(key.KeyMetadata.KeyState) == Enabled)
 	d.Set("deletion_window_in_days", d.Get("deletion_window_in_days").(int))
-	d.Set("arn", key.KeyMetadata.Arn)
+	d.Set("arn", key.Arn)
+	d.Set("key_state", key.KeyState)
+	d.Set("delete_date", key.DeleteDate)
+	d.Set("creator", key.Creator)
+	if key.AutomaticRotation != "" {
+		d.Set("automatic_rotation", key.AutomaticRotation)
+	}
+	d.Set("rotation_interval", key.RotationInterval)
 
 	return nil
 }
@@ -118,6 +147,10 @@ func resourceAlicloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("DescribeKey got an error: %#v.", err)
 		}
 
+		if KeyState(key.KeyMetadata.KeyState) == PendingDeletion {
+			return fmt.Errorf("KMS key %s is pending deletion and it can not be enabled or disabled.", d.Id())
+		}
+
 		if d.Get("is_enabled").(bool) && KeyState(key.KeyMetadata.KeyState) == Disabled {
 			if _, err := conn.EnableKey(d.Id()); err != nil {
 				return fmt.Errorf("Enable key got an error: %#v.", err)
@@ -132,6 +165,17 @@ func resourceAlicloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("is_enabled")
 	}
 
+	if d.HasChange("automatic_rotation") || d.HasChange("rotation_interval") {
+		enabled := d.Get("automatic_rotation").(string) == KmsRotationEnabled
+		if !d.IsNewResource() || enabled {
+			if err := meta.(*AliyunClient).UpdateKmsKeyRotationPolicy(d.Id(), enabled, d.Get("rotation_interval").(string)); err != nil {
+				return fmt.Errorf("UpdateRotationPolicy got an error: %#v.", err)
+			}
+		}
+		d.SetPartial("automatic_rotation")
+		d.SetPartial("rotation_interval")
+	}
+
 	d.Partial(false)
 
 	return resourceAlicloudKmsKeyRead(d, meta)
@@ -140,6 +184,10 @@ func resourceAlicloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceAlicloudKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AliyunClient).kmsconn
 
+	if d.Get("key_state").(string) == string(PendingDeletion) {
+		return nil
+	}
+
 	if _, err := conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionArgs{
 		KeyId:               d.Id(),
 		PendingWindowInDays: d.Get("deletion_window_in_days").(int),
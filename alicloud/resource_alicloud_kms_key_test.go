@@ -22,6 +22,9 @@ func TestAccAlicloudKmsKey_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAlicloudKmsKeyExists("alicloud_kms_key.key", &keyBefore),
 					resource.TestCheckResourceAttr("alicloud_kms_key.key", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "key_state", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "automatic_rotation", "Disabled"),
+					resource.TestCheckResourceAttrSet("alicloud_kms_key.key", "creator"),
 				),
 			},
 			{
@@ -29,6 +32,34 @@ func TestAccAlicloudKmsKey_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAlicloudKmsKeyExists("alicloud_kms_key.key", &keyAfter),
 					resource.TestCheckResourceAttr("alicloud_kms_key.key", "is_enabled", "false"),
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "key_state", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudKmsKey_rotation(t *testing.T) {
+	var key kms.KeyMetadata
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudKmsKeyRotation,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudKmsKeyExists("alicloud_kms_key.key", &key),
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "automatic_rotation", "Enabled"),
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "rotation_interval", "31536000s"),
+				),
+			},
+			{
+				Config: testAlicloudKmsKeyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudKmsKeyExists("alicloud_kms_key.key", &key),
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "automatic_rotation", "Disabled"),
 				),
 			},
 		},
@@ -60,6 +91,40 @@ func testAccCheckAlicloudKmsKeyExists(name string, key *kms.KeyMetadata) resourc
 	}
 }
 
+func TestAccAlicloudKmsKey_pendingDeletion(t *testing.T) {
+	var key kms.KeyMetadata
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlicloudKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudKmsKeyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudKmsKeyExists("alicloud_kms_key.key", &key),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AliyunClient).kmsconn
+					if _, err := conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionArgs{
+						KeyId:               key.KeyId,
+						PendingWindowInDays: 7,
+					}); err != nil {
+						t.Fatalf("ScheduleKeyDeletion got an error: %#v", err)
+					}
+				},
+				Config: testAlicloudKmsKeyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alicloud_kms_key.key", "key_state", "PendingDeletion"),
+					resource.TestCheckResourceAttrSet("alicloud_kms_key.key", "delete_date"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAlicloudKmsKeyDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AliyunClient).kmsconn
 
@@ -99,3 +164,11 @@ resource "alicloud_kms_key" "key" {
     deletion_window_in_days = 7
     is_enabled = false
 }`
+
+const testAlicloudKmsKeyRotation = `
+resource "alicloud_kms_key" "key" {
+    description = "Terraform acc test"
+    deletion_window_in_days = 7
+    automatic_rotation = "Enabled"
+    rotation_interval = "365d"
+}`
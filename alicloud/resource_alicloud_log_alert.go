@@ -0,0 +1,357 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogAlertCreate,
+		Read:   resourceAlicloudLogAlertRead,
+		Update: resourceAlicloudLogAlertUpdate,
+		Delete: resourceAlicloudLogAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alert_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"condition": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dashboard": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chart_title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"store_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"time_span_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Custom",
+							ValidateFunc: validateAllowedStringValue([]string{"Custom", "Relative", "Truncated"}),
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "-60s",
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "now",
+						},
+					},
+				},
+			},
+			"schedule_interval": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"throttling": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"notify_threshold": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 100),
+			},
+			"notification": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateAllowedStringValue([]string{string(LogNotificationDingTalk), string(LogNotificationEmail),
+								string(LogNotificationWebhook), string(LogNotificationMNS), string(LogNotificationMessageCenter), string(LogNotificationSMS)}),
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"GET", "POST", "PUT", "DELETE"}),
+						},
+						"headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogAlertCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	alertName := d.Get("alert_name").(string)
+
+	alert, err := buildLogAlert(d)
+	if err != nil {
+		return fmt.Errorf("Creating alert of log service got an error: %#v", err)
+	}
+	alert.State = LogJobEnabled
+	if !d.Get("enabled").(bool) {
+		alert.State = LogJobDisabled
+	}
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, "/jobs", alert, nil); err != nil {
+		return fmt.Errorf("Creating alert of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + alertName)
+
+	return resourceAlicloudLogAlertRead(d, meta)
+}
+
+func resourceAlicloudLogAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	alert, err := client.DescribeLogAlert(parameters[0], parameters[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project_name", parameters[0])
+	d.Set("alert_name", parameters[1])
+	d.Set("display_name", alert.DisplayName)
+	d.Set("description", alert.Description)
+	d.Set("condition", alert.Configuration.Condition)
+	d.Set("dashboard", alert.Configuration.Dashboard)
+	d.Set("schedule_interval", alert.Schedule.Interval)
+	d.Set("throttling", alert.Configuration.Throttling)
+	d.Set("notify_threshold", alert.Configuration.NotifyThreshold)
+	d.Set("enabled", alert.State != LogJobDisabled)
+
+	var queries []map[string]interface{}
+	for _, query := range alert.Configuration.QueryList {
+		queries = append(queries, map[string]interface{}{
+			"chart_title":    query.ChartTitle,
+			"store_name":     query.LogStore,
+			"query":          query.Query,
+			"time_span_type": query.TimeSpanType,
+			"start":          query.Start,
+			"end":            query.End,
+		})
+	}
+	if err := d.Set("query", queries); err != nil {
+		return fmt.Errorf("Setting query got an error: %#v.", err)
+	}
+
+	var notifications []map[string]interface{}
+	for _, notification := range alert.Configuration.NotificationList {
+		notifications = append(notifications, map[string]interface{}{
+			"type":        notification.Type,
+			"content":     notification.Content,
+			"service_uri": notification.ServiceUri,
+			"email_list":  notification.EmailList,
+			"method":      notification.Method,
+			"headers":     notification.Headers,
+		})
+	}
+	if err := d.Set("notification", notifications); err != nil {
+		return fmt.Errorf("Setting notification got an error: %#v.", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogAlertUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+	uri := "/jobs/" + url.PathEscape(parameters[1])
+
+	d.Partial(true)
+
+	update := false
+	for _, key := range []string{"display_name", "description", "condition", "dashboard", "query",
+		"schedule_interval", "throttling", "notify_threshold", "notification"} {
+		if d.HasChange(key) {
+			update = true
+			d.SetPartial(key)
+		}
+	}
+	if update {
+		alert, err := buildLogAlert(d)
+		if err != nil {
+			return fmt.Errorf("Updating alert of log service got an error: %#v", err)
+		}
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri, alert, nil); err != nil {
+			return fmt.Errorf("Updating alert of log service got an error: %#v", err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		action := "disable"
+		if d.Get("enabled").(bool) {
+			action = "enable"
+		}
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri+"?action="+action, nil, nil); err != nil {
+			return fmt.Errorf("Updating alert of log service got an error: %#v", err)
+		}
+		d.SetPartial("enabled")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudLogAlertRead(d, meta)
+}
+
+func resourceAlicloudLogAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		uri := "/jobs/" + url.PathEscape(parameters[1])
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodDelete, uri, nil, nil); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting alert of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogAlert(parameters[0], parameters[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe alert of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting alert of log service timeout."))
+	})
+}
+
+func buildLogAlert(d *schema.ResourceData) (*LogAlert, error) {
+	alert := &LogAlert{
+		Name:        d.Get("alert_name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Type:        LogJobTypeAlert,
+		Schedule: LogAlertSchedule{
+			Type:     LogScheduleTypeFixedRate,
+			Interval: d.Get("schedule_interval").(string),
+		},
+		Configuration: LogAlertConfiguration{
+			Condition:       d.Get("condition").(string),
+			Dashboard:       d.Get("dashboard").(string),
+			NotifyThreshold: d.Get("notify_threshold").(int),
+			Throttling:      d.Get("throttling").(string),
+		},
+	}
+	if alert.DisplayName == "" {
+		alert.DisplayName = alert.Name
+	}
+
+	for _, q := range d.Get("query").([]interface{}) {
+		query := q.(map[string]interface{})
+		alert.Configuration.QueryList = append(alert.Configuration.QueryList, LogAlertQuery{
+			ChartTitle:   query["chart_title"].(string),
+			LogStore:     query["store_name"].(string),
+			Query:        query["query"].(string),
+			TimeSpanType: query["time_span_type"].(string),
+			Start:        query["start"].(string),
+			End:          query["end"].(string),
+		})
+	}
+
+	for _, n := range d.Get("notification").([]interface{}) {
+		notification := n.(map[string]interface{})
+		item := LogAlertNotification{
+			Type:       notification["type"].(string),
+			Content:    notification["content"].(string),
+			ServiceUri: notification["service_uri"].(string),
+			EmailList:  expandStringList(notification["email_list"].([]interface{})),
+			Method:     notification["method"].(string),
+		}
+		if headers, ok := notification["headers"].(map[string]interface{}); ok && len(headers) > 0 {
+			item.Headers = make(map[string]string)
+			for k, v := range headers {
+				item.Headers[k] = v.(string)
+			}
+		}
+
+		switch LogNotificationType(item.Type) {
+		case LogNotificationEmail:
+			if len(item.EmailList) < 1 {
+				return nil, fmt.Errorf("'email_list' is required for the %s notification.", item.Type)
+			}
+		case LogNotificationDingTalk, LogNotificationWebhook, LogNotificationMNS:
+			if item.ServiceUri == "" {
+				return nil, fmt.Errorf("'service_uri' is required for the %s notification.", item.Type)
+			}
+		}
+		alert.Configuration.NotificationList = append(alert.Configuration.NotificationList, item)
+	}
+	return alert, nil
+}
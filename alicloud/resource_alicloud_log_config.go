@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -33,13 +34,31 @@ func resourceAlicloudLogConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"input_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      LogConfigInputFile,
+				ValidateFunc: validateAllowedStringValue([]string{LogConfigInputFile, LogConfigInputJson, LogConfigInputPlugin}),
+			},
 			"log_path": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"file_pattern": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"plugin": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonContent,
+				DiffSuppressFunc: jsonStringDiffSuppressFunc,
+			},
+			"advanced": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonContent,
+				DiffSuppressFunc: jsonStringDiffSuppressFunc,
 			},
 			"log_sample": &schema.Schema{
 				Type:     schema.TypeString,
@@ -184,80 +203,15 @@ func resourceAlicloudLogConfigCreate(d *schema.ResourceData, meta interface{}) e
 	projectName := d.Get("project_name").(string)
 	storeName := d.Get("store_name").(string)
 	configName := d.Get("config_name").(string)
-	logPath := d.Get("log_path").(string)
-	filePattern := d.Get("file_pattern").(string)
-
-	// InputDetail parameter
-	inputDetail := sls.InputDetail{}
-	inputDetail.LogType = "common_reg_log"
-	inputDetail.LogPath = logPath
-	inputDetail.FilePattern = filePattern
-	if topicFormat, ok := d.GetOk("topic_format"); ok {
-		inputDetail.TopicFormat = topicFormat.(string)
-	}
-	inputDetail.LocalStorage = d.Get("local_storage").(bool)
-	if timeKey, ok := d.GetOk("time_key"); ok {
-		inputDetail.TimeKey = timeKey.(string)
-	}
-	if timeFormat, ok := d.GetOk("time_format"); ok {
-		inputDetail.TimeFormat = timeFormat.(string)
-	}
-	if logBeginRegex, ok := d.GetOk("log_begin_regex"); ok {
-		inputDetail.LogBeginRegex = logBeginRegex.(string)
-	}
-	if regex, ok := d.GetOk("regex"); ok {
-		inputDetail.Regex = regex.(string)
-	}
-	if keys, ok := d.GetOk("keys"); ok {
-		var keysStr []string
-		for _, v := range keys.([]interface{}) {
-			keysStr = append(keysStr, v.(string))
-		}
-		inputDetail.Keys = keysStr
-	} else {
-		inputDetail.Keys = []string{"content"}
-	}
-	if filterKeys, ok := d.GetOk("filter_keys"); ok {
-		var filterKeysStr []string
-		for _, v := range filterKeys.([]interface{}) {
-			filterKeysStr = append(filterKeysStr, v.(string))
-		}
-		inputDetail.FilterKeys = filterKeysStr
-	} else {
-		inputDetail.FilterKeys = make([]string, 1)
-	}
-	if filterRegex, ok := d.GetOk("filter_regex"); ok {
-		var filterRegexStr []string
-		for _, v := range filterRegex.([]interface{}) {
-			filterRegexStr = append(filterRegexStr, v.(string))
-		}
-		inputDetail.FilterRegex = filterRegexStr
-	} else {
-		inputDetail.FilterRegex = make([]string, 1)
-	}
-	if topicFormat, ok := d.GetOk("topic_format"); ok {
-		inputDetail.TopicFormat = topicFormat.(string)
-	}
-
-	// OutPutDetail parameter
-	outputDetail := sls.OutputDetail{
-		ProjectName:  projectName,
-		LogStoreName: storeName,
+	if err := checkLogConfigInput(d); err != nil {
+		return fmt.Errorf("Creating config of log service got an error: %#v", err)
 	}
-
-	//LogConfig parameter
-	logConfig := &sls.LogConfig{}
-	logConfig.Name = configName
-	logConfig.InputType = "file"
-	logConfig.OutputType = "LogService"
-	logConfig.InputDetail = inputDetail
-	logConfig.OutputDetail = outputDetail
-	if logSample, ok := d.GetOk("log_sample"); ok {
-		logConfig.LogSample = logSample.(string)
+	logConfig, err := buildLogConfig(d)
+	if err != nil {
+		return fmt.Errorf("Creating config of log service got an error: %#v", err)
 	}
 
-	err := client.slsconn.CreateConfig(projectName, logConfig)
-	if err != nil {
+	if err := client.slsconn.CreateConfig(projectName, logConfig); err != nil {
 		return fmt.Errorf("Creating config of log service got an error: %#v", err)
 	}
 
@@ -284,7 +238,37 @@ func resourceAlicloudLogConfigRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("store_name", parameters[1])
 	d.Set("config_name", parameters[2])
 	d.Set("log_sample", logConfig.LogSample)
-	if inputDetail, ok := sls.ConvertToInputDetail(logConfig.InputDetail); ok {
+
+	detail, ok := logConfig.InputDetail.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Reading config of log service got an error: %#v", "the input detail is not an object")
+	}
+	if advanced, ok := detail[LogConfigAdvancedKey]; ok {
+		if _, ok := d.GetOk("advanced"); ok {
+			b, err := json.Marshal(advanced)
+			if err != nil {
+				return fmt.Errorf("Reading config of log service got an error: %#v", err)
+			}
+			d.Set("advanced", string(b))
+		}
+	}
+
+	inputDetail, err := convertLogConfigInputDetail(detail)
+	if err != nil {
+		return fmt.Errorf("Reading config of log service got an error: %#v", err)
+	}
+	if logConfig.InputType == sls.InputTypePlugin {
+		d.Set("input_type", LogConfigInputPlugin)
+		b, err := json.Marshal(detail[LogConfigPluginKey])
+		if err != nil {
+			return fmt.Errorf("Reading config of log service got an error: %#v", err)
+		}
+		d.Set("plugin", string(b))
+	} else {
+		d.Set("input_type", LogConfigInputFile)
+		if inputDetail.LogType == LogTypeJson {
+			d.Set("input_type", LogConfigInputJson)
+		}
 		d.Set("log_path", inputDetail.LogPath)
 		d.Set("file_pattern", inputDetail.FilePattern)
 		if _, ok := d.GetOk("keys"); ok {
@@ -302,8 +286,6 @@ func resourceAlicloudLogConfigRead(d *schema.ResourceData, meta interface{}) err
 		if _, ok := d.GetOk("filter_regex"); ok {
 			d.Set("filter_regex", inputDetail.FilterRegex)
 		}
-	} else {
-		return fmt.Errorf("Reading config of log service got an error: %#v", err)
 	}
 
 	var s []map[string]interface{}
@@ -317,7 +299,7 @@ func resourceAlicloudLogConfigRead(d *schema.ResourceData, meta interface{}) err
 		"resource_type": "alicloud_log_config",
 	}
 
-	if inputDetail, ok := sls.ConvertToInputDetail(logConfig.InputDetail); ok {
+	if logConfig.InputType != sls.InputTypePlugin {
 		mapping["log_path"] = inputDetail.LogPath
 		mapping["file_pattern"] = inputDetail.FilePattern
 		if _, ok := d.GetOk("keys"); ok {
@@ -349,6 +331,10 @@ func resourceAlicloudLogConfigRead(d *schema.ResourceData, meta interface{}) err
 func resourceAlicloudLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
+	if err := checkLogConfigInput(d); err != nil {
+		return fmt.Errorf("Updating config of log service got an error: %#v", err)
+	}
+
 	d.Partial(true)
 	update := false
 
@@ -362,6 +348,12 @@ func resourceAlicloudLogConfigUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Updating stroe of log service got an error: %#v", "Cannot modify parameter config_name")
 	}
 
+	for _, key := range []string{"input_type", "plugin", "advanced"} {
+		if d.HasChange(key) {
+			update = true
+			d.SetPartial(key)
+		}
+	}
 	if d.HasChange("log_sample") {
 		update = true
 		d.SetPartial("log_sample")
@@ -412,18 +404,64 @@ func resourceAlicloudLogConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !d.IsNewResource() && update {
-		projectName := d.Get("project_name").(string)
-		storeName := d.Get("store_name").(string)
-		configName := d.Get("config_name").(string)
-		logPath := d.Get("log_path").(string)
-		filePattern := d.Get("file_pattern").(string)
+		logConfig, err := buildLogConfig(d)
+		if err != nil {
+			return fmt.Errorf("Updating config of log service got an error: %#v", err)
+		}
+
+		if err := client.slsconn.UpdateConfig(d.Get("project_name").(string), logConfig); err != nil {
+			return fmt.Errorf("Updating config of log service got an error: %#v", err)
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudLogConfigRead(d, meta)
+}
+
+// checkLogConfigInput checks the fields required by the input type.
+func checkLogConfigInput(d *schema.ResourceData) error {
+	inputType := d.Get("input_type").(string)
+	if inputType == LogConfigInputPlugin {
+		if _, ok := d.GetOk("plugin"); !ok {
+			return fmt.Errorf("'plugin' is required when 'input_type' is %s.", LogConfigInputPlugin)
+		}
+		return nil
+	}
+	_, pathOk := d.GetOk("log_path")
+	_, patternOk := d.GetOk("file_pattern")
+	if !pathOk || !patternOk {
+		return fmt.Errorf("'log_path' and 'file_pattern' are required when 'input_type' is %s.", inputType)
+	}
+	return nil
+}
+
+// buildLogConfig builds the logtail config. The file and json inputs are built from the common fields,
+// and the plugin input is built from the 'plugin' JSON. The 'advanced' JSON is kept in the input detail.
+func buildLogConfig(d *schema.ResourceData) (*sls.LogConfig, error) {
+	inputType := d.Get("input_type").(string)
+	detail := make(map[string]interface{})
 
+	if inputType == LogConfigInputPlugin {
+		var pluginDetail interface{}
+		if err := json.Unmarshal([]byte(d.Get("plugin").(string)), &pluginDetail); err != nil {
+			return nil, err
+		}
+		detail[LogConfigPluginKey] = pluginDetail
+		detail["localStorage"] = d.Get("local_storage").(bool)
+		if filterKeys, ok := d.GetOk("filter_keys"); ok {
+			detail["filterKey"] = expandStringList(filterKeys.([]interface{}))
+			detail["filterRegex"] = expandStringList(d.Get("filter_regex").([]interface{}))
+		}
+	} else {
 		// InputDetail parameter
 		inputDetail := sls.InputDetail{}
-		inputDetail.LogType = "common_reg_log"
-		inputDetail.LogPath = logPath
-		inputDetail.FilePattern = filePattern
-
+		inputDetail.LogType = LogTypeCommonRegex
+		if inputType == LogConfigInputJson {
+			inputDetail.LogType = LogTypeJson
+		}
+		inputDetail.LogPath = d.Get("log_path").(string)
+		inputDetail.FilePattern = d.Get("file_pattern").(string)
 		if topicFormat, ok := d.GetOk("topic_format"); ok {
 			inputDetail.TopicFormat = topicFormat.(string)
 		}
@@ -441,55 +479,68 @@ func resourceAlicloudLogConfigUpdate(d *schema.ResourceData, meta interface{}) e
 			inputDetail.Regex = regex.(string)
 		}
 		if keys, ok := d.GetOk("keys"); ok {
-			var keysStr []string
-			for _, v := range keys.([]interface{}) {
-				keysStr = append(keysStr, v.(string))
-			}
-			inputDetail.Keys = keysStr
+			inputDetail.Keys = expandStringList(keys.([]interface{}))
+		} else {
+			inputDetail.Keys = []string{"content"}
 		}
 		if filterKeys, ok := d.GetOk("filter_keys"); ok {
-			var filterKeysStr []string
-			for _, v := range filterKeys.([]interface{}) {
-				filterKeysStr = append(filterKeysStr, v.(string))
-			}
-			inputDetail.FilterKeys = filterKeysStr
+			inputDetail.FilterKeys = expandStringList(filterKeys.([]interface{}))
+		} else {
+			inputDetail.FilterKeys = make([]string, 1)
 		}
 		if filterRegex, ok := d.GetOk("filter_regex"); ok {
-			var filterRegexStr []string
-			for _, v := range filterRegex.([]interface{}) {
-				filterRegexStr = append(filterRegexStr, v.(string))
-			}
-			inputDetail.FilterRegex = filterRegexStr
-		}
-		if topicFormat, ok := d.GetOk("topic_format"); ok {
-			inputDetail.TopicFormat = topicFormat.(string)
+			inputDetail.FilterRegex = expandStringList(filterRegex.([]interface{}))
+		} else {
+			inputDetail.FilterRegex = make([]string, 1)
 		}
 
-		// OutPutDetail parameter
-		outputDetail := sls.OutputDetail{
-			ProjectName:  projectName,
-			LogStoreName: storeName,
+		b, err := json.Marshal(inputDetail)
+		if err != nil {
+			return nil, err
 		}
-
-		//LogConfig parameter
-		logConfig := &sls.LogConfig{}
-		logConfig.Name = configName
-		logConfig.InputType = "file"
-		logConfig.OutputType = "LogService"
-		logConfig.InputDetail = inputDetail
-		logConfig.OutputDetail = outputDetail
-		if logSample, ok := d.GetOk("log_sample"); ok {
-			logConfig.LogSample = logSample.(string)
+		if err := json.Unmarshal(b, &detail); err != nil {
+			return nil, err
 		}
+	}
 
-		if err := client.slsconn.UpdateConfig(projectName, logConfig); err != nil {
-			return fmt.Errorf("Updating config of log service got an error: %#v", err)
+	if advanced, ok := d.GetOk("advanced"); ok {
+		var advancedDetail interface{}
+		if err := json.Unmarshal([]byte(advanced.(string)), &advancedDetail); err != nil {
+			return nil, err
 		}
+		detail[LogConfigAdvancedKey] = advancedDetail
 	}
 
-	d.Partial(false)
+	//LogConfig parameter
+	logConfig := &sls.LogConfig{}
+	logConfig.Name = d.Get("config_name").(string)
+	logConfig.InputType = sls.InputTypeFile
+	if inputType == LogConfigInputPlugin {
+		logConfig.InputType = sls.InputTypePlugin
+	}
+	logConfig.OutputType = "LogService"
+	logConfig.InputDetail = detail
+	logConfig.OutputDetail = sls.OutputDetail{
+		ProjectName:  d.Get("project_name").(string),
+		LogStoreName: d.Get("store_name").(string),
+	}
+	if logSample, ok := d.GetOk("log_sample"); ok {
+		logConfig.LogSample = logSample.(string)
+	}
+	return logConfig, nil
+}
 
-	return resourceAlicloudLogConfigRead(d, meta)
+// convertLogConfigInputDetail converts the input detail of any log type to the common one.
+func convertLogConfigInputDetail(detail map[string]interface{}) (*sls.InputDetail, error) {
+	b, err := json.Marshal(detail)
+	if err != nil {
+		return nil, err
+	}
+	inputDetail := &sls.InputDetail{}
+	if err := json.Unmarshal(b, inputDetail); err != nil {
+		return nil, err
+	}
+	return inputDetail, nil
 }
 
 func resourceAlicloudLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,135 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogConsumerGroupCreate,
+		Read:   resourceAlicloudLogConsumerGroupRead,
+		Update: resourceAlicloudLogConsumerGroupUpdate,
+		Delete: resourceAlicloudLogConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"store_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateIntegerInRange(1, 86400),
+			},
+			"order": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	storeName := d.Get("store_name").(string)
+	groupName := d.Get("consumer_group_name").(string)
+
+	group := sls.ConsumerGroup{
+		ConsumerGroupName: groupName,
+		Timeout:           d.Get("timeout").(int),
+		InOrder:           d.Get("order").(bool),
+	}
+	if err := client.slsconn.CreateConsumerGroup(projectName, storeName, group); err != nil {
+		return fmt.Errorf("Creating consumer group of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + storeName + COMMA_SEPARATED + groupName)
+
+	return resourceAlicloudLogConsumerGroupRead(d, meta)
+}
+
+func resourceAlicloudLogConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	group, err := client.DescribeLogConsumerGroup(parameters[0], parameters[1], parameters[2])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project_name", parameters[0])
+	d.Set("store_name", parameters[1])
+	d.Set("consumer_group_name", group.ConsumerGroupName)
+	d.Set("timeout", group.Timeout)
+	d.Set("order", group.InOrder)
+
+	return nil
+}
+
+func resourceAlicloudLogConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	if d.HasChange("timeout") || d.HasChange("order") {
+		group := sls.ConsumerGroup{
+			ConsumerGroupName: parameters[2],
+			Timeout:           d.Get("timeout").(int),
+			InOrder:           d.Get("order").(bool),
+		}
+		if err := client.slsconn.UpdateConsumerGroup(parameters[0], parameters[1], group); err != nil {
+			return fmt.Errorf("Updating consumer group of log service got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudLogConsumerGroupRead(d, meta)
+}
+
+func resourceAlicloudLogConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if err := client.slsconn.DeleteConsumerGroup(parameters[0], parameters[1], parameters[2]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting consumer group of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogConsumerGroup(parameters[0], parameters[1], parameters[2]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe consumer group of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting consumer group of log service timeout."))
+	})
+}
@@ -0,0 +1,287 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogDashboardCreate,
+		Read:   resourceAlicloudLogDashboardRead,
+		Update: resourceAlicloudLogDashboardUpdate,
+		Delete: resourceAlicloudLogDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dashboard_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"chart": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"chart_list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateAllowedStringValue([]string{"table", "line", "bar", "pie", "area", "map",
+								"single", "flow", "sankey", "wordcloud", "treemap", "markdown"}),
+						},
+						"store_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "-86400s",
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "now",
+						},
+						"x_axis": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"y_axis": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"x_pos": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"y_pos": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"width": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  5,
+						},
+						"height": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  5,
+						},
+					},
+				},
+			},
+			"chart_list": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"chart"},
+				ValidateFunc:     validateJsonContent,
+				DiffSuppressFunc: jsonStringDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogDashboardCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	dashboardName := d.Get("dashboard_name").(string)
+
+	dashboard, err := buildLogDashboard(d)
+	if err != nil {
+		return fmt.Errorf("Creating dashboard of log service got an error: %#v", err)
+	}
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, "/dashboards", dashboard, nil); err != nil {
+		return fmt.Errorf("Creating dashboard of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + dashboardName)
+
+	return resourceAlicloudLogDashboardRead(d, meta)
+}
+
+func resourceAlicloudLogDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	dashboard, err := client.DescribeLogDashboard(parameters[0], parameters[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project_name", parameters[0])
+	d.Set("dashboard_name", parameters[1])
+	d.Set("display_name", dashboard.DisplayName)
+	d.Set("description", dashboard.Description)
+
+	// The charts are kept in the same form as they are configured, and the raw JSON is compared semantically.
+	if _, ok := d.GetOk("chart_list"); ok {
+		d.Set("chart_list", string(dashboard.Charts))
+		return nil
+	}
+
+	var chartList []LogChart
+	if len(dashboard.Charts) > 0 {
+		if err := json.Unmarshal(dashboard.Charts, &chartList); err != nil {
+			return fmt.Errorf("Parsing charts of dashboard %s got an error: %#v.", d.Id(), err)
+		}
+	}
+	var charts []map[string]interface{}
+	for _, chart := range chartList {
+		charts = append(charts, map[string]interface{}{
+			"title":      chart.Title,
+			"type":       chart.Type,
+			"store_name": chart.Search.Logstore,
+			"topic":      chart.Search.Topic,
+			"query":      chart.Search.Query,
+			"start":      chart.Search.Start,
+			"end":        chart.Search.End,
+			"x_axis":     chart.Display.XAxis,
+			"y_axis":     chart.Display.YAxis,
+			"x_pos":      chart.Display.XPos,
+			"y_pos":      chart.Display.YPos,
+			"width":      chart.Display.Width,
+			"height":     chart.Display.Height,
+		})
+	}
+	if err := d.Set("chart", charts); err != nil {
+		return fmt.Errorf("Setting chart got an error: %#v.", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	if d.HasChange("display_name") || d.HasChange("description") || d.HasChange("chart") || d.HasChange("chart_list") {
+		dashboard, err := buildLogDashboard(d)
+		if err != nil {
+			return fmt.Errorf("Updating dashboard of log service got an error: %#v", err)
+		}
+		uri := "/dashboards/" + url.PathEscape(parameters[1])
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri, dashboard, nil); err != nil {
+			return fmt.Errorf("Updating dashboard of log service got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudLogDashboardRead(d, meta)
+}
+
+func resourceAlicloudLogDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		uri := "/dashboards/" + url.PathEscape(parameters[1])
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodDelete, uri, nil, nil); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting dashboard of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogDashboard(parameters[0], parameters[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe dashboard of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting dashboard of log service timeout."))
+	})
+}
+
+// buildLogDashboard builds the dashboard whose charts come from either the 'chart' blocks or the 'chart_list' JSON.
+// The 'chart_list' JSON is sent as it is, so that the chart settings which the 'chart' blocks do not support are kept.
+func buildLogDashboard(d *schema.ResourceData) (*LogDashboard, error) {
+	dashboard := &LogDashboard{
+		DashboardName: d.Get("dashboard_name").(string),
+		DisplayName:   d.Get("display_name").(string),
+		Description:   d.Get("description").(string),
+	}
+	if dashboard.DisplayName == "" {
+		dashboard.DisplayName = dashboard.DashboardName
+	}
+
+	if chartList, ok := d.GetOk("chart_list"); ok {
+		dashboard.Charts = json.RawMessage(chartList.(string))
+		return dashboard, nil
+	}
+
+	charts := []LogChart{}
+	for _, c := range d.Get("chart").([]interface{}) {
+		chart := c.(map[string]interface{})
+		charts = append(charts, LogChart{
+			Title: chart["title"].(string),
+			Type:  chart["type"].(string),
+			Search: LogChartSearch{
+				Logstore: chart["store_name"].(string),
+				Topic:    chart["topic"].(string),
+				Query:    chart["query"].(string),
+				Start:    chart["start"].(string),
+				End:      chart["end"].(string),
+			},
+			Display: LogChartDisplay{
+				XAxis:       expandStringList(chart["x_axis"].([]interface{})),
+				YAxis:       expandStringList(chart["y_axis"].([]interface{})),
+				XPos:        chart["x_pos"].(int),
+				YPos:        chart["y_pos"].(int),
+				Width:       chart["width"].(int),
+				Height:      chart["height"].(int),
+				DisplayName: chart["title"].(string),
+			},
+		})
+	}
+	raw, err := json.Marshal(charts)
+	if err != nil {
+		return nil, fmt.Errorf("Marshal charts got an error: %#v", err)
+	}
+	dashboard.Charts = raw
+	return dashboard, nil
+}
@@ -0,0 +1,273 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogEtlJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogEtlJobCreate,
+		Read:   resourceAlicloudLogEtlJobRead,
+		Update: resourceAlicloudLogEtlJobUpdate,
+		Delete: resourceAlicloudLogEtlJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"etl_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"store_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"script": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"to_time": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"sink": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"project_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"store_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      LogEtlJobRunning,
+				ValidateFunc: validateAllowedStringValue([]string{LogEtlJobRunning, LogEtlJobStopped}),
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogEtlJobCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	etlName := d.Get("etl_name").(string)
+
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, "/jobs", buildLogEtlJob(d), nil); err != nil {
+		return fmt.Errorf("Creating etl job of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + etlName)
+
+	// The job starts running once it is created.
+	if d.Get("status").(string) == LogEtlJobStopped {
+		if err := client.ProcessSlsCommonRequest(projectName, http.MethodPut, "/jobs/"+url.PathEscape(etlName)+"?action=STOP", nil, nil); err != nil {
+			return fmt.Errorf("Stopping etl job of log service got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudLogEtlJobRead(d, meta)
+}
+
+func resourceAlicloudLogEtlJobRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	job, err := client.DescribeLogEtlJob(parameters[0], parameters[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	config := job.Configuration
+	d.Set("project_name", parameters[0])
+	d.Set("etl_name", parameters[1])
+	d.Set("display_name", job.DisplayName)
+	d.Set("description", job.Description)
+	d.Set("store_name", config.Logstore)
+	d.Set("script", config.Script)
+	d.Set("role_arn", config.RoleArn)
+	d.Set("from_time", config.FromTime)
+	d.Set("to_time", config.ToTime)
+	d.Set("parameters", config.Parameters)
+	if job.Status == LogEtlJobRunning || job.Status == LogEtlJobStopped {
+		d.Set("status", job.Status)
+	}
+
+	var sinks []map[string]interface{}
+	for _, sink := range config.Sinks {
+		sinks = append(sinks, map[string]interface{}{
+			"name":         sink.Name,
+			"project_name": sink.Project,
+			"store_name":   sink.Logstore,
+			"role_arn":     sink.RoleArn,
+			"endpoint":     sink.Endpoint,
+		})
+	}
+	if err := d.Set("sink", sinks); err != nil {
+		return fmt.Errorf("Setting sink got an error: %#v.", err)
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogEtlJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+	uri := "/jobs/" + url.PathEscape(parameters[1])
+
+	d.Partial(true)
+
+	update := false
+	for _, key := range []string{"display_name", "description", "script", "role_arn", "parameters", "sink"} {
+		if d.HasChange(key) {
+			update = true
+			d.SetPartial(key)
+		}
+	}
+	if update {
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri, buildLogEtlJob(d), nil); err != nil {
+			return fmt.Errorf("Updating etl job of log service got an error: %#v", err)
+		}
+	}
+
+	if d.HasChange("status") {
+		action := "START"
+		if d.Get("status").(string) == LogEtlJobStopped {
+			action = "STOP"
+		}
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri+"?action="+action, nil, nil); err != nil {
+			return fmt.Errorf("Updating etl job of log service got an error: %#v", err)
+		}
+		d.SetPartial("status")
+	}
+
+	d.Partial(false)
+
+	return resourceAlicloudLogEtlJobRead(d, meta)
+}
+
+func resourceAlicloudLogEtlJobDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodDelete, "/jobs/"+url.PathEscape(parameters[1]), nil, nil); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting etl job of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogEtlJob(parameters[0], parameters[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe etl job of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting etl job of log service timeout."))
+	})
+}
+
+func buildLogEtlJob(d *schema.ResourceData) *LogEtlJob {
+	job := &LogEtlJob{
+		Name:        d.Get("etl_name").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Type:        LogJobTypeETL,
+		Schedule: LogAlertSchedule{
+			Type: LogScheduleTypeResident,
+		},
+		Configuration: LogEtlConfiguration{
+			Logstore:   d.Get("store_name").(string),
+			Script:     d.Get("script").(string),
+			RoleArn:    d.Get("role_arn").(string),
+			FromTime:   int64(d.Get("from_time").(int)),
+			ToTime:     int64(d.Get("to_time").(int)),
+			Version:    2,
+			Parameters: make(map[string]string),
+		},
+	}
+	if job.DisplayName == "" {
+		job.DisplayName = job.Name
+	}
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		job.Configuration.Parameters[k] = v.(string)
+	}
+	for _, s := range d.Get("sink").([]interface{}) {
+		sink := s.(map[string]interface{})
+		job.Configuration.Sinks = append(job.Configuration.Sinks, LogEtlSink{
+			Name:     sink["name"].(string),
+			Type:     LogEtlSinkTypeLog,
+			Project:  sink["project_name"].(string),
+			Logstore: sink["store_name"].(string),
+			RoleArn:  sink["role_arn"].(string),
+			Endpoint: sink["endpoint"].(string),
+		})
+	}
+	return job
+}
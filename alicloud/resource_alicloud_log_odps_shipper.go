@@ -0,0 +1,182 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogOdpsShipper() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogOdpsShipperCreate,
+		Read:   resourceAlicloudLogOdpsShipperRead,
+		Update: resourceAlicloudLogOdpsShipperUpdate,
+		Delete: resourceAlicloudLogOdpsShipperDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"store_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"shipper_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"odps_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"odps_project": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"odps_table": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fields": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partition_column": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partition_time_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%Y_%m_%d_%H_%M",
+			},
+			"buffer_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validateIntegerInRange(1800, 3600),
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogOdpsShipperCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	storeName := d.Get("store_name").(string)
+	shipperName := d.Get("shipper_name").(string)
+
+	uri := fmt.Sprintf("/logstores/%s/shipper", url.PathEscape(storeName))
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, uri, buildLogOdpsShipper(d), nil); err != nil {
+		return fmt.Errorf("Creating odps shipper of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + storeName + COMMA_SEPARATED + shipperName)
+
+	return resourceAlicloudLogOdpsShipperRead(d, meta)
+}
+
+func resourceAlicloudLogOdpsShipperRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	shipper, err := client.DescribeLogOdpsShipper(parameters[0], parameters[1], parameters[2])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	config := shipper.TargetConfiguration
+	d.Set("project_name", parameters[0])
+	d.Set("store_name", parameters[1])
+	d.Set("shipper_name", parameters[2])
+	d.Set("odps_endpoint", config.OdpsEndpoint)
+	d.Set("odps_project", config.OdpsProject)
+	d.Set("odps_table", config.OdpsTable)
+	d.Set("fields", config.Fields)
+	d.Set("partition_column", config.PartitionColumn)
+	d.Set("partition_time_format", config.PartitionTimeFormat)
+	d.Set("buffer_interval", config.BufferInterval)
+
+	return nil
+}
+
+func resourceAlicloudLogOdpsShipperUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	update := false
+	for _, key := range []string{"odps_endpoint", "odps_project", "odps_table", "fields", "partition_column",
+		"partition_time_format", "buffer_interval"} {
+		if d.HasChange(key) {
+			update = true
+		}
+	}
+
+	if update {
+		uri := fmt.Sprintf("/logstores/%s/shipper/%s", url.PathEscape(parameters[1]), url.PathEscape(parameters[2]))
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri, buildLogOdpsShipper(d), nil); err != nil {
+			return fmt.Errorf("Updating odps shipper of log service got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudLogOdpsShipperRead(d, meta)
+}
+
+func resourceAlicloudLogOdpsShipperDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		uri := fmt.Sprintf("/logstores/%s/shipper/%s", url.PathEscape(parameters[1]), url.PathEscape(parameters[2]))
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodDelete, uri, nil, nil); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting odps shipper of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogOdpsShipper(parameters[0], parameters[1], parameters[2]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe odps shipper of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting odps shipper of log service timeout."))
+	})
+}
+
+func buildLogOdpsShipper(d *schema.ResourceData) *LogOdpsShipper {
+	return &LogOdpsShipper{
+		ShipperName: d.Get("shipper_name").(string),
+		TargetType:  LogShipperTargetOdps,
+		TargetConfiguration: LogOdpsShipperTargetConfig{
+			OdpsEndpoint:        d.Get("odps_endpoint").(string),
+			OdpsProject:         d.Get("odps_project").(string),
+			OdpsTable:           d.Get("odps_table").(string),
+			Fields:              expandStringList(d.Get("fields").([]interface{})),
+			PartitionColumn:     expandStringList(d.Get("partition_column").([]interface{})),
+			PartitionTimeFormat: d.Get("partition_time_format").(string),
+			BufferInterval:      d.Get("buffer_interval").(int),
+		},
+	}
+}
@@ -0,0 +1,323 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogOssShipper() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogOssShipperCreate,
+		Read:   resourceAlicloudLogOssShipperRead,
+		Update: resourceAlicloudLogOssShipperUpdate,
+		Delete: resourceAlicloudLogOssShipperDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"store_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"shipper_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"oss_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"buffer_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validateIntegerInRange(300, 900),
+			},
+			"buffer_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				ValidateFunc: validateIntegerInRange(5, 256),
+			},
+			"compress_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "snappy",
+				ValidateFunc: validateAllowedStringValue([]string{"snappy", "none"}),
+			},
+			"path_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%Y/%m/%d/%H/%M",
+			},
+			"format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validateAllowedStringValue([]string{"json", "csv", "parquet"}),
+			},
+			"json_enable_tag": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"csv_columns": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"csv_delimiter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ",",
+			},
+			"csv_quote": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "\"",
+			},
+			"csv_null_identifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"csv_header": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"parquet_column": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"string", "boolean", "int32", "int64", "float", "double"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogOssShipperCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	storeName := d.Get("store_name").(string)
+	shipperName := d.Get("shipper_name").(string)
+
+	shipper, err := buildLogOssShipper(d)
+	if err != nil {
+		return fmt.Errorf("Creating oss shipper of log service got an error: %#v", err)
+	}
+	uri := fmt.Sprintf("/logstores/%s/shipper", url.PathEscape(storeName))
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, uri, shipper, nil); err != nil {
+		return fmt.Errorf("Creating oss shipper of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + storeName + COMMA_SEPARATED + shipperName)
+
+	return resourceAlicloudLogOssShipperRead(d, meta)
+}
+
+func resourceAlicloudLogOssShipperRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	shipper, err := client.DescribeLogOssShipper(parameters[0], parameters[1], parameters[2])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	config := shipper.TargetConfiguration
+	d.Set("project_name", parameters[0])
+	d.Set("store_name", parameters[1])
+	d.Set("shipper_name", parameters[2])
+	d.Set("oss_bucket", config.OssBucket)
+	d.Set("oss_prefix", config.OssPrefix)
+	d.Set("role_arn", config.RoleArn)
+	d.Set("buffer_interval", config.BufferInterval)
+	d.Set("buffer_size", config.BufferSize)
+	d.Set("compress_type", config.CompressType)
+	d.Set("path_format", config.PathFormat)
+	d.Set("format", config.Storage.Format)
+
+	detail := config.Storage.Detail
+	switch config.Storage.Format {
+	case "json":
+		if enableTag, ok := detail["enableTag"].(bool); ok {
+			d.Set("json_enable_tag", enableTag)
+		}
+	case "csv":
+		var columns []string
+		if list, ok := detail["columns"].([]interface{}); ok {
+			for _, c := range list {
+				columns = append(columns, fmt.Sprint(c))
+			}
+		}
+		d.Set("csv_columns", columns)
+		if delimiter, ok := detail["delimiter"].(string); ok {
+			d.Set("csv_delimiter", delimiter)
+		}
+		if quote, ok := detail["quote"].(string); ok {
+			d.Set("csv_quote", quote)
+		}
+		if nullIdentifier, ok := detail["nullIdentifier"].(string); ok {
+			d.Set("csv_null_identifier", nullIdentifier)
+		}
+		if header, ok := detail["header"].(bool); ok {
+			d.Set("csv_header", header)
+		}
+	case "parquet":
+		var columns []map[string]interface{}
+		if list, ok := detail["columns"].([]interface{}); ok {
+			for _, c := range list {
+				if column, ok := c.(map[string]interface{}); ok {
+					columns = append(columns, map[string]interface{}{
+						"name": column["name"],
+						"type": column["type"],
+					})
+				}
+			}
+		}
+		if err := d.Set("parquet_column", columns); err != nil {
+			return fmt.Errorf("Setting parquet_column got an error: %#v.", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAlicloudLogOssShipperUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	update := false
+	for _, key := range []string{"oss_bucket", "oss_prefix", "role_arn", "buffer_interval", "buffer_size", "compress_type",
+		"path_format", "format", "json_enable_tag", "csv_columns", "csv_delimiter", "csv_quote", "csv_null_identifier",
+		"csv_header", "parquet_column"} {
+		if d.HasChange(key) {
+			update = true
+		}
+	}
+
+	if update {
+		shipper, err := buildLogOssShipper(d)
+		if err != nil {
+			return fmt.Errorf("Updating oss shipper of log service got an error: %#v", err)
+		}
+		uri := fmt.Sprintf("/logstores/%s/shipper/%s", url.PathEscape(parameters[1]), url.PathEscape(parameters[2]))
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri, shipper, nil); err != nil {
+			return fmt.Errorf("Updating oss shipper of log service got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudLogOssShipperRead(d, meta)
+}
+
+func resourceAlicloudLogOssShipperDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		uri := fmt.Sprintf("/logstores/%s/shipper/%s", url.PathEscape(parameters[1]), url.PathEscape(parameters[2]))
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodDelete, uri, nil, nil); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting oss shipper of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogOssShipper(parameters[0], parameters[1], parameters[2]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe oss shipper of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting oss shipper of log service timeout."))
+	})
+}
+
+// buildLogOssShipper builds the shipper whose storage detail depends on the format.
+func buildLogOssShipper(d *schema.ResourceData) (*LogOssShipper, error) {
+	format := d.Get("format").(string)
+	detail := make(map[string]interface{})
+	switch format {
+	case "json":
+		detail["enableTag"] = d.Get("json_enable_tag").(bool)
+	case "csv":
+		columns := expandStringList(d.Get("csv_columns").([]interface{}))
+		if len(columns) < 1 {
+			return nil, fmt.Errorf("'csv_columns' is required when the format is csv.")
+		}
+		detail["columns"] = columns
+		detail["delimiter"] = d.Get("csv_delimiter").(string)
+		detail["quote"] = d.Get("csv_quote").(string)
+		detail["nullIdentifier"] = d.Get("csv_null_identifier").(string)
+		detail["header"] = d.Get("csv_header").(bool)
+	case "parquet":
+		var columns []map[string]interface{}
+		for _, c := range d.Get("parquet_column").([]interface{}) {
+			column := c.(map[string]interface{})
+			columns = append(columns, map[string]interface{}{
+				"name": column["name"].(string),
+				"type": column["type"].(string),
+			})
+		}
+		if len(columns) < 1 {
+			return nil, fmt.Errorf("'parquet_column' is required when the format is parquet.")
+		}
+		detail["columns"] = columns
+	}
+
+	return &LogOssShipper{
+		ShipperName: d.Get("shipper_name").(string),
+		TargetType:  LogShipperTargetOss,
+		TargetConfiguration: LogOssShipperTargetConfig{
+			OssBucket:      d.Get("oss_bucket").(string),
+			OssPrefix:      d.Get("oss_prefix").(string),
+			RoleArn:        d.Get("role_arn").(string),
+			BufferInterval: d.Get("buffer_interval").(int),
+			BufferSize:     d.Get("buffer_size").(int),
+			CompressType:   d.Get("compress_type").(string),
+			PathFormat:     d.Get("path_format").(string),
+			Storage: LogOssShipperStorage{
+				Format: format,
+				Detail: detail,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,143 @@
+package alicloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAlicloudLogSavedSearch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudLogSavedSearchCreate,
+		Read:   resourceAlicloudLogSavedSearchRead,
+		Update: resourceAlicloudLogSavedSearchUpdate,
+		Delete: resourceAlicloudLogSavedSearchDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"search_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"store_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search_query": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"topic": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudLogSavedSearchCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	projectName := d.Get("project_name").(string)
+	searchName := d.Get("search_name").(string)
+
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, "/savedsearches", buildLogSavedSearch(d), nil); err != nil {
+		return fmt.Errorf("Creating saved search of log service got an error: %#v", err)
+	}
+
+	d.SetId(projectName + COMMA_SEPARATED + searchName)
+
+	return resourceAlicloudLogSavedSearchRead(d, meta)
+}
+
+func resourceAlicloudLogSavedSearchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	search, err := client.DescribeLogSavedSearch(parameters[0], parameters[1])
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project_name", parameters[0])
+	d.Set("search_name", parameters[1])
+	d.Set("store_name", search.Logstore)
+	d.Set("search_query", search.SearchQuery)
+	d.Set("topic", search.Topic)
+	d.Set("display_name", search.DisplayName)
+
+	return nil
+}
+
+func resourceAlicloudLogSavedSearchUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	if d.HasChange("store_name") || d.HasChange("search_query") || d.HasChange("topic") || d.HasChange("display_name") {
+		uri := "/savedsearches/" + url.PathEscape(parameters[1])
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, uri, buildLogSavedSearch(d), nil); err != nil {
+			return fmt.Errorf("Updating saved search of log service got an error: %#v", err)
+		}
+	}
+
+	return resourceAlicloudLogSavedSearchRead(d, meta)
+}
+
+func resourceAlicloudLogSavedSearchDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AliyunClient)
+	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
+
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		uri := "/savedsearches/" + url.PathEscape(parameters[1])
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodDelete, uri, nil, nil); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Deleting saved search of log service got an error: %#v", err))
+		}
+
+		if _, err := client.DescribeLogSavedSearch(parameters[0], parameters[1]); err != nil {
+			if NotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("Describe saved search of log service got an error: %#v", err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("Deleting saved search of log service timeout."))
+	})
+}
+
+func buildLogSavedSearch(d *schema.ResourceData) *LogSavedSearch {
+	search := &LogSavedSearch{
+		SavedSearchName: d.Get("search_name").(string),
+		SearchQuery:     d.Get("search_query").(string),
+		Logstore:        d.Get("store_name").(string),
+		Topic:           d.Get("topic").(string),
+		DisplayName:     d.Get("display_name").(string),
+	}
+	if search.DisplayName == "" {
+		search.DisplayName = search.SavedSearchName
+	}
+	return search
+}
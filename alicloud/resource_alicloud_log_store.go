@@ -2,6 +2,8 @@ package alicloud
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -29,15 +31,60 @@ func resourceAlicloudLogStore() *schema.Resource {
 				Required: true,
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  30,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validateIntegerInRange(1, 3650),
 			},
 			"shard_count": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
 				Default:  1,
 			},
+			"auto_split": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"max_split_shard_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(0, 64),
+			},
+			"enable_web_tracking": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"append_meta": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"shards": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"begin_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			// Computed values
 			"alicloud_log_store": {
 				Type:     schema.TypeList,
@@ -83,13 +130,15 @@ func resourceAlicloudLogStoreCreate(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*AliyunClient)
 	projectName := d.Get("project_name").(string)
 	storeName := d.Get("store_name").(string)
-	ttl := d.Get("ttl").(int)
-	shardCount := d.Get("shard_count").(int)
-
-	err := client.slsconn.CreateLogStore(projectName, storeName, ttl, shardCount)
+	store, err := buildLogStoreDetail(d)
 	if err != nil {
 		return fmt.Errorf("Creating store of log service got an error: %#v", err)
 	}
+	store.ShardCount = d.Get("shard_count").(int)
+
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodPost, "/logstores", store, nil); err != nil {
+		return fmt.Errorf("Creating store of log service got an error: %#v", err)
+	}
 
 	d.SetId(projectName + COMMA_SEPARATED + storeName)
 
@@ -100,7 +149,7 @@ func resourceAlicloudLogStoreRead(d *schema.ResourceData, meta interface{}) erro
 	client := meta.(*AliyunClient)
 	parameters := strings.Split(d.Id(), COMMA_SEPARATED)
 
-	logStore, err := client.slsconn.GetLogStore(parameters[0], parameters[1])
+	logStore, err := client.DescribeLogStore(parameters[0], parameters[1])
 
 	if err != nil {
 		if NotFoundError(err) {
@@ -113,7 +162,35 @@ func resourceAlicloudLogStoreRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("project_name", parameters[0])
 	d.Set("store_name", parameters[1])
 	d.Set("ttl", logStore.TTL)
-	d.Set("shard_count", logStore.ShardCount)
+	d.Set("auto_split", logStore.AutoSplit)
+	d.Set("max_split_shard_count", logStore.MaxSplitShard)
+	d.Set("enable_web_tracking", logStore.EnableTracking)
+	d.Set("append_meta", logStore.AppendMeta)
+
+	shards, err := client.slsconn.ListShards(parameters[0], parameters[1])
+	if err != nil {
+		return fmt.Errorf("Listing shards of log service got an error: %#v", err)
+	}
+	var shardList []map[string]interface{}
+	readWrite := 0
+	for _, shard := range shards {
+		if shard.Status == LogShardReadWrite {
+			readWrite++
+		}
+		shardList = append(shardList, map[string]interface{}{
+			"id":        shard.ShardID,
+			"status":    shard.Status,
+			"begin_key": shard.InclusiveBeginKey,
+			"end_key":   shard.ExclusiveBeginKey,
+		})
+	}
+	if err := d.Set("shards", shardList); err != nil {
+		return fmt.Errorf("Setting shards got an error: %#v.", err)
+	}
+	// The shards split automatically do not make a diff, but shrinking them still does.
+	if !logStore.AutoSplit || readWrite < d.Get("shard_count").(int) {
+		d.Set("shard_count", readWrite)
+	}
 
 	var s []map[string]interface{}
 
@@ -149,22 +226,35 @@ func resourceAlicloudLogStoreUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("store_name") && !d.IsNewResource() {
 		return fmt.Errorf("Updating stroe of log service got an error: %#v", "Cannot modify parameter store_name")
 	}
-	if d.HasChange("ttl") {
-		update = true
-		d.SetPartial("ttl")
-	}
-	if d.HasChange("shard_count") {
-		update = true
-		d.SetPartial("shard_count")
+	for _, key := range []string{"ttl", "auto_split", "max_split_shard_count", "enable_web_tracking", "append_meta"} {
+		if d.HasChange(key) {
+			update = true
+			d.SetPartial(key)
+		}
 	}
 
 	if !d.IsNewResource() && update {
-		ttl := d.Get("ttl").(int)
-		shardCount := d.Get("shard_count").(int)
+		store, err := buildLogStoreDetail(d)
+		if err != nil {
+			return fmt.Errorf("Updating store of log service got an error: %#v", err)
+		}
+		shards, err := client.DescribeLogStoreReadWriteShards(parameters[0], parameters[1])
+		if err != nil {
+			return fmt.Errorf("Updating store of log service got an error: %#v", err)
+		}
+		store.ShardCount = len(shards)
+
+		if err := client.ProcessSlsCommonRequest(parameters[0], http.MethodPut, "/logstores/"+url.PathEscape(parameters[1]), store, nil); err != nil {
+			return fmt.Errorf("Updating store of log service got an error: %#v", err)
+		}
+	}
 
-		if err := client.slsconn.UpdateLogStore(parameters[0], parameters[1], ttl, shardCount); err != nil {
+	// The shards are split or merged in place, so the logs are kept.
+	if !d.IsNewResource() && d.HasChange("shard_count") {
+		if err := client.ScaleLogStoreShards(parameters[0], parameters[1], d.Get("shard_count").(int)); err != nil {
 			return fmt.Errorf("Updating store of log service got an error: %#v", err)
 		}
+		d.SetPartial("shard_count")
 	}
 
 	d.Partial(false)
@@ -172,6 +262,24 @@ func resourceAlicloudLogStoreUpdate(d *schema.ResourceData, meta interface{}) er
 	return resourceAlicloudLogStoreRead(d, meta)
 }
 
+func buildLogStoreDetail(d *schema.ResourceData) (*LogStoreDetail, error) {
+	store := &LogStoreDetail{
+		Name:           d.Get("store_name").(string),
+		TTL:            d.Get("ttl").(int),
+		AutoSplit:      d.Get("auto_split").(bool),
+		EnableTracking: d.Get("enable_web_tracking").(bool),
+		AppendMeta:     d.Get("append_meta").(bool),
+	}
+	store.MaxSplitShard = d.Get("max_split_shard_count").(int)
+	if store.AutoSplit && store.MaxSplitShard < 1 {
+		return nil, fmt.Errorf("'max_split_shard_count' must be greater than 0 when 'auto_split' is true.")
+	}
+	if !store.AutoSplit && store.MaxSplitShard > 0 {
+		return nil, fmt.Errorf("'max_split_shard_count' can only be set when 'auto_split' is true.")
+	}
+	return store, nil
+}
+
 func resourceAlicloudLogStoreDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AliyunClient)
 
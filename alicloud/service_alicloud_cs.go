@@ -1,14 +1,25 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"bytes"
 	"log"
 
+	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
+	"github.com/denverdino/aliyungo/ecs"
+	"github.com/denverdino/aliyungo/ess"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mitchellh/go-homedir"
 	"gopkg.in/yaml.v2"
 )
 
@@ -119,3 +130,653 @@ func (client *AliyunClient) WaitForContainerApplication(clusterName, appName str
 	}
 	return nil
 }
+
+type KubernetesClusterUserConfig struct {
+	Config string `json:"config"`
+}
+
+// KubernetesClusterCredentials contains the kubeconfig and certificates used to access the API server of a kubernetes cluster.
+type KubernetesClusterCredentials struct {
+	KubeConfig    string
+	ClientCert    string
+	ClientKey     string
+	ClusterCaCert string
+}
+
+func (client *AliyunClient) DescribeKubernetesClusterCredentials(clusterId string) (creds KubernetesClusterCredentials, err error) {
+	certs, err := client.csconn.GetClusterCerts(clusterId)
+	if err != nil {
+		return creds, fmt.Errorf("Getting certs of kubernetes cluster %s got an error: %#v.", clusterId, err)
+	}
+
+	var config KubernetesClusterUserConfig
+	if err := client.csconn.Invoke("", http.MethodGet, "/k8s/"+clusterId+"/user_config", nil, nil, &config); err != nil {
+		return creds, fmt.Errorf("Getting kube config of kubernetes cluster %s got an error: %#v.", clusterId, err)
+	}
+
+	creds.KubeConfig = config.Config
+	creds.ClientCert = certs.Cert
+	creds.ClientKey = certs.Key
+	creds.ClusterCaCert = certs.CA
+	return
+}
+
+// setKubernetesClusterCredentials sets the credentials attributes and writes them to the files specified by the '*_path' fields.
+// Unless always is true, a file is only written when the resource is created or its path has been changed.
+func setKubernetesClusterCredentials(d *schema.ResourceData, creds KubernetesClusterCredentials, always bool) error {
+	contents := map[string]string{
+		"kube_config":     creds.KubeConfig,
+		"client_cert":     creds.ClientCert,
+		"client_key":      creds.ClientKey,
+		"cluster_ca_cert": creds.ClusterCaCert,
+	}
+	for key, content := range contents {
+		d.Set(key, content)
+
+		v, ok := d.GetOk(key + "_path")
+		if !ok || v.(string) == "" {
+			continue
+		}
+		if !always && !d.IsNewResource() && !d.HasChange(key+"_path") {
+			continue
+		}
+		path, err := homedir.Expand(v.(string))
+		if err != nil {
+			return fmt.Errorf("Expanding homedir in %s (%s) got an error: %#v.", key+"_path", v.(string), err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			return fmt.Errorf("Writing %s to file %s got an error: %#v.", key, path, err)
+		}
+	}
+	return nil
+}
+
+// KubernetesStackExtendedArgs adds the stack parameters which the SDK does not support yet.
+type KubernetesStackExtendedArgs struct {
+	cs.KubernetesStackArgs
+	Network      string `json:"Network,omitempty"`
+	NodeCIDRMask string `json:"NodeCIDRMask,omitempty"`
+	ProxyMode    string `json:"ProxyMode,omitempty"`
+	UserData     string `json:"UserData,omitempty"`
+}
+
+type KubernetesCreationExtendedArgs struct {
+	ClusterType       string                      `json:"cluster_type"`
+	Name              string                      `json:"name"`
+	DisableRollback   bool                        `json:"disable_rollback"`
+	TimeoutMins       int64                       `json:"timeout_mins"`
+	KubernetesVersion string                      `json:"kubernetes_version"`
+	StackParams       KubernetesStackExtendedArgs `json:"stack_params"`
+	Addons            []KubernetesAddon           `json:"addons,omitempty"`
+}
+
+type KubernetesAddon struct {
+	Name   string `json:"name"`
+	Config string `json:"config,omitempty"`
+}
+
+// KubernetesClusterDetail contains the cluster attributes which are not in the SDK ClusterType.
+type KubernetesClusterDetail struct {
+	ClusterId      string `json:"cluster_id"`
+	CurrentVersion string `json:"current_version"`
+	MetaData       string `json:"meta_data"`
+	// Parameters are the stack parameters which the cluster is created with, like KubernetesStackExtendedArgs.
+	Parameters map[string]string `json:"parameters"`
+}
+
+// KubernetesClusterMetaData is stored as a JSON string in the cluster detail 'meta_data'.
+type KubernetesClusterMetaData struct {
+	Addons []KubernetesAddon `json:"Addons"`
+}
+
+// KubernetesMultiAZCreationArgs is used to create a kubernetes cluster whose masters and workers span 3 vswitches.
+type KubernetesMultiAZCreationArgs struct {
+	ClusterType              string            `json:"cluster_type"`
+	Name                     string            `json:"name"`
+	DisableRollback          bool              `json:"disable_rollback"`
+	TimeoutMins              int64             `json:"timeout_mins"`
+	MultiAZ                  bool              `json:"multi_az"`
+	KubernetesVersion        string            `json:"kubernetes_version"`
+	VPCID                    string            `json:"vpcid"`
+	VSwitchIdA               string            `json:"vswitch_id_a"`
+	VSwitchIdB               string            `json:"vswitch_id_b"`
+	VSwitchIdC               string            `json:"vswitch_id_c"`
+	MasterInstanceTypeA      string            `json:"master_instance_type_a"`
+	MasterInstanceTypeB      string            `json:"master_instance_type_b"`
+	MasterInstanceTypeC      string            `json:"master_instance_type_c"`
+	MasterSystemDiskCategory ecs.DiskCategory  `json:"master_system_disk_category"`
+	MasterSystemDiskSize     int64             `json:"master_system_disk_size"`
+	WorkerInstanceTypeA      string            `json:"worker_instance_type_a"`
+	WorkerInstanceTypeB      string            `json:"worker_instance_type_b"`
+	WorkerInstanceTypeC      string            `json:"worker_instance_type_c"`
+	WorkerSystemDiskCategory ecs.DiskCategory  `json:"worker_system_disk_category"`
+	WorkerSystemDiskSize     int64             `json:"worker_system_disk_size"`
+	NumOfNodesA              int64             `json:"num_of_nodes_a"`
+	NumOfNodesB              int64             `json:"num_of_nodes_b"`
+	NumOfNodesC              int64             `json:"num_of_nodes_c"`
+	LoginPassword            string            `json:"login_password"`
+	ContainerCIDR            string            `json:"container_cidr"`
+	ServiceCIDR              string            `json:"service_cidr"`
+	SSHFlags                 bool              `json:"ssh_flags"`
+	CloudMonitorFlags        bool              `json:"cloud_monitor_flags"`
+	SNatEntry                bool              `json:"snat_entry"`
+	Network                  string            `json:"network,omitempty"`
+	NodeCIDRMask             string            `json:"node_cidr_mask,omitempty"`
+	ProxyMode                string            `json:"proxy_mode,omitempty"`
+	UserData                 string            `json:"user_data,omitempty"`
+	Addons                   []KubernetesAddon `json:"addons,omitempty"`
+}
+
+type KubernetesMultiAZScaleArgs struct {
+	DisableRollback     bool   `json:"disable_rollback"`
+	TimeoutMins         int64  `json:"timeout_mins"`
+	LoginPassword       string `json:"login_password"`
+	WorkerInstanceTypeA string `json:"worker_instance_type_a"`
+	WorkerInstanceTypeB string `json:"worker_instance_type_b"`
+	WorkerInstanceTypeC string `json:"worker_instance_type_c"`
+	NumOfNodesA         int64  `json:"num_of_nodes_a"`
+	NumOfNodesB         int64  `json:"num_of_nodes_b"`
+	NumOfNodesC         int64  `json:"num_of_nodes_c"`
+}
+
+// ManagedKubernetesCreationArgs is used to create a kubernetes cluster whose masters are hosted by the container service.
+type ManagedKubernetesCreationArgs struct {
+	ClusterType              string           `json:"cluster_type"`
+	Name                     string           `json:"name"`
+	DisableRollback          bool             `json:"disable_rollback"`
+	TimeoutMins              int64            `json:"timeout_mins"`
+	KubernetesVersion        string           `json:"kubernetes_version"`
+	RegionId                 string           `json:"region_id"`
+	ZoneId                   string           `json:"zoneid"`
+	VPCID                    string           `json:"vpcid,omitempty"`
+	VSwitchId                string           `json:"vswitchid,omitempty"`
+	WorkerInstanceType       string           `json:"worker_instance_type"`
+	WorkerSystemDiskCategory ecs.DiskCategory `json:"worker_system_disk_category"`
+	WorkerSystemDiskSize     int64            `json:"worker_system_disk_size"`
+	NumOfNodes               int64            `json:"num_of_nodes"`
+	LoginPassword            string           `json:"login_password"`
+	ContainerCIDR            string           `json:"container_cidr"`
+	ServiceCIDR              string           `json:"service_cidr"`
+	CloudMonitorFlags        bool             `json:"cloud_monitor_flags"`
+	SNatEntry                bool             `json:"snat_entry"`
+}
+
+type ManagedKubernetesScaleArgs struct {
+	DisableRollback          bool             `json:"disable_rollback"`
+	TimeoutMins              int64            `json:"timeout_mins"`
+	WorkerInstanceType       string           `json:"worker_instance_type"`
+	WorkerSystemDiskCategory ecs.DiskCategory `json:"worker_system_disk_category"`
+	WorkerSystemDiskSize     int64            `json:"worker_system_disk_size"`
+	NumOfNodes               int64            `json:"num_of_nodes"`
+	LoginPassword            string           `json:"login_password"`
+}
+
+// KubernetesMasterURL is the API server endpoints stored as a JSON string in the cluster 'master_url'.
+type KubernetesMasterURL struct {
+	ApiServerEndpoint         string `json:"api_server_endpoint"`
+	IntranetApiServerEndpoint string `json:"intranet_api_server_endpoint"`
+}
+
+// CreateContainerCluster creates a cluster with the arguments which the SDK does not support yet.
+func (client *AliyunClient) CreateContainerCluster(region common.Region, args interface{}) (cluster cs.ClusterCreationResponse, err error) {
+	err = client.csconn.Invoke(region, http.MethodPost, "/clusters", nil, args, &cluster)
+	return
+}
+
+// ResizeContainerCluster resizes a cluster with the arguments which the SDK does not support yet.
+func (client *AliyunClient) ResizeContainerCluster(clusterId string, args interface{}) error {
+	return client.csconn.Invoke("", http.MethodPut, "/clusters/"+clusterId, nil, args, nil)
+}
+
+// DescribeKubernetesClusterNodes returns all of nodes in the kubernetes cluster page by page.
+// The nodes are not available at once after the cluster is running, so it will wait for them for a while.
+func (client *AliyunClient) DescribeKubernetesClusterNodes(clusterId string) (nodes []KubernetesClusterNode, err error) {
+	pageNumber := 1
+	for {
+		result, pagination, err := client.getKubernetesClusterNodes(clusterId, pageNumber)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] GetKubernetesClusterNodes got an error: %#v.", err)
+		}
+
+		if pageNumber == 1 && (len(result) == 0 || result[0].InstanceId == "") {
+			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+				tmp, page, err := client.getKubernetesClusterNodes(clusterId, pageNumber)
+				if err != nil {
+					return resource.NonRetryableError(fmt.Errorf("[ERROR] GetKubernetesClusterNodes got an error: %#v.", err))
+				}
+				if len(tmp) > 0 && tmp[0].InstanceId != "" {
+					result = tmp
+					pagination = page
+					return nil
+				}
+				return resource.RetryableError(fmt.Errorf("[ERROR] There is no any nodes in kubernetes cluster %s.", clusterId))
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		nodes = append(nodes, result...)
+
+		if len(result) < pagination.PageSize {
+			break
+		}
+		pageNumber += 1
+	}
+	return nodes, nil
+}
+
+// getKubernetesClusterNodes gets one page of the nodes with the attributes which are not in the SDK KubernetesNodeType.
+func (client *AliyunClient) getKubernetesClusterNodes(clusterId string, pageNumber int) (nodes []KubernetesClusterNode, pagination *cs.PaginationResult, err error) {
+	var response struct {
+		Page  cs.PaginationResult     `json:"page"`
+		Nodes []KubernetesClusterNode `json:"nodes"`
+	}
+	err = client.csconn.Invoke("", http.MethodGet, "/clusters/"+clusterId+"/nodes?pageNumber="+strconv.Itoa(pageNumber)+"&pageSize=50", nil, nil, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Nodes, &response.Page, nil
+}
+
+// isKubernetesClusterOwnWorker returns whether the node is a worker created along with the cluster,
+// rather than a node of a node pool or an existing instance attached to the cluster.
+func isKubernetesClusterOwnWorker(node KubernetesClusterNode) bool {
+	return node.InstanceRole == "Worker" && node.NodePoolId == "" && node.Source == KubernetesNodeSourceROS
+}
+
+// splitKubernetesWorkerNumber spreads the workers across the vswitches of a multi-AZ cluster as evenly as possible.
+func splitKubernetesWorkerNumber(workerNumber int) (numbers [KubernetesMultiAZVSwitchNumber]int64) {
+	for i := 0; i < KubernetesMultiAZVSwitchNumber; i++ {
+		numbers[i] = int64(workerNumber / KubernetesMultiAZVSwitchNumber)
+		if i < workerNumber%KubernetesMultiAZVSwitchNumber {
+			numbers[i] += 1
+		}
+	}
+	return
+}
+
+func parseKubernetesMasterURL(masterURL string) (endpoints KubernetesMasterURL, err error) {
+	if masterURL == "" {
+		return
+	}
+	err = json.Unmarshal([]byte(masterURL), &endpoints)
+	return
+}
+
+type KubernetesNodePool struct {
+	NodePoolInfo     KubernetesNodePoolInfo             `json:"nodepool_info"`
+	ScalingGroup     KubernetesNodePoolScalingGroup     `json:"scaling_group"`
+	KubernetesConfig KubernetesNodePoolKubernetesConfig `json:"kubernetes_config"`
+	AutoScaling      KubernetesNodePoolAutoScaling      `json:"auto_scaling"`
+	Count            int64                              `json:"count,omitempty"`
+	Status           *KubernetesNodePoolStatus          `json:"status,omitempty"`
+}
+
+type KubernetesNodePoolInfo struct {
+	NodePoolId string `json:"nodepool_id,omitempty"`
+	Name       string `json:"name"`
+}
+
+type KubernetesNodePoolScalingGroup struct {
+	ScalingGroupId     string                        `json:"scaling_group_id,omitempty"`
+	SecurityGroupId    string                        `json:"security_group_id,omitempty"`
+	VSwitchIds         []string                      `json:"vswitch_ids"`
+	InstanceTypes      []string                      `json:"instance_types"`
+	SystemDiskCategory string                        `json:"system_disk_category"`
+	SystemDiskSize     int64                         `json:"system_disk_size"`
+	DataDisks          []KubernetesNodePoolDisk      `json:"data_disks"`
+	LoginPassword      string                        `json:"login_password,omitempty"`
+	KeyPair            string                        `json:"key_pair,omitempty"`
+	SpotStrategy       string                        `json:"spot_strategy,omitempty"`
+	SpotPriceLimit     []KubernetesNodePoolSpotPrice `json:"spot_price_limit,omitempty"`
+}
+
+type KubernetesNodePoolDisk struct {
+	Category  string `json:"category"`
+	Size      int64  `json:"size"`
+	Encrypted string `json:"encrypted"`
+}
+
+type KubernetesNodePoolSpotPrice struct {
+	InstanceType string  `json:"instance_type"`
+	PriceLimit   float64 `json:"price_limit,string"`
+}
+
+type KubernetesNodePoolKubernetesConfig struct {
+	Labels []KubernetesNodePoolLabel `json:"labels"`
+	Taints []KubernetesNodePoolTaint `json:"taints"`
+}
+
+type KubernetesNodePoolLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type KubernetesNodePoolTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+type KubernetesNodePoolAutoScaling struct {
+	Enable       bool   `json:"enable"`
+	MinInstances int64  `json:"min_instances"`
+	MaxInstances int64  `json:"max_instances"`
+	Type         string `json:"type,omitempty"`
+}
+
+type KubernetesNodePoolStatus struct {
+	State      KubernetesNodePoolState `json:"state"`
+	TotalNodes int64                   `json:"total_nodes"`
+}
+
+func (client *AliyunClient) DescribeKubernetesNodePool(clusterId, nodePoolId string) (pool KubernetesNodePool, err error) {
+	err = client.csconn.Invoke("", http.MethodGet, "/clusters/"+clusterId+"/nodepools/"+nodePoolId, nil, nil, &pool)
+	if err != nil {
+		if IsExceptedError(err, ErrorNodePoolNotFound) || IsExceptedError(err, ErrorClusterNotFound) {
+			return pool, GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Node Pool", nodePoolId))
+		}
+		return pool, fmt.Errorf("Describing node pool %s got an error: %#v.", nodePoolId, err)
+	}
+	if pool.NodePoolInfo.NodePoolId != nodePoolId {
+		return pool, GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Node Pool", nodePoolId))
+	}
+	return
+}
+
+func (client *AliyunClient) WaitForKubernetesNodePool(clusterId, nodePoolId string, state KubernetesNodePoolState, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	for {
+		pool, err := client.DescribeKubernetesNodePool(clusterId, nodePoolId)
+		if err != nil {
+			return err
+		}
+
+		if pool.Status != nil {
+			if pool.Status.State == state {
+				break
+			}
+			if pool.Status.State == KubernetesNodePoolFailed {
+				return fmt.Errorf("Node pool %s is %s.", nodePoolId, pool.Status.State)
+			}
+		}
+		timeout = timeout - DefaultIntervalShort
+		if timeout <= 0 {
+			return GetTimeErrorFromString(GetTimeoutMessage("Kubernetes Node Pool", string(state)))
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+	}
+	return nil
+}
+
+// ScaleOutKubernetesNodePool adds the nodes to the node pool.
+func (client *AliyunClient) ScaleOutKubernetesNodePool(clusterId, nodePoolId string, count int) error {
+	args := map[string]int{"count": count}
+	return client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/nodepools/"+nodePoolId+"/nodes", nil, args, nil)
+}
+
+// ScaleInKubernetesNodePool drains and removes the newest nodes from the cluster, and then removes their instances
+// from the scaling group behind the node pool, because the node pool API cannot release nodes by itself.
+func (client *AliyunClient) ScaleInKubernetesNodePool(clusterId, scalingGroupId string, count int) error {
+	var instances []ess.ScalingInstanceItemType
+	pagination := getPagination(1, 50)
+	for {
+		result, _, err := client.essconn.DescribeScalingInstances(&ess.DescribeScalingInstancesArgs{
+			RegionId:       client.Region,
+			ScalingGroupId: scalingGroupId,
+			Pagination:     pagination,
+		})
+		if err != nil {
+			return fmt.Errorf("DescribeScalingInstances got an error: %#v", err)
+		}
+		instances = append(instances, result...)
+		if len(result) < pagination.PageSize {
+			break
+		}
+		pagination.PageNumber += 1
+	}
+
+	if count > len(instances) {
+		count = len(instances)
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].CreationTime > instances[j].CreationTime
+	})
+
+	var instanceIds, nodeNames []string
+	for _, inst := range instances[:count] {
+		instanceIds = append(instanceIds, inst.InstanceId)
+		node, err := client.DescribeKubernetesClusterNode(clusterId, inst.InstanceId)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		nodeNames = append(nodeNames, node.NodeName)
+	}
+
+	// The instances are released by the scaling group, so the nodes are only drained and removed from the cluster.
+	if len(nodeNames) > 0 {
+		if err := client.RemoveKubernetesClusterNodes(clusterId, nodeNames, true, false); err != nil {
+			return fmt.Errorf("Removing nodes %s from Kubernetes Cluster got an error: %#v", strings.Join(instanceIds, COMMA_SEPARATED), err)
+		}
+		if err := client.WaitForKubernetesClusterNodesRemoved(clusterId, instanceIds, DefaultTimeoutMedium); err != nil {
+			return err
+		}
+	}
+
+	return client.EssRemoveInstances(scalingGroupId, instanceIds)
+}
+
+func (client *AliyunClient) DescribeKubernetesClusterDetail(clusterId string) (cluster KubernetesClusterDetail, err error) {
+	err = client.csconn.Invoke("", http.MethodGet, "/clusters/"+clusterId, nil, nil, &cluster)
+	return
+}
+
+// UpgradeKubernetesCluster upgrades the kubernetes components of the cluster in place.
+func (client *AliyunClient) UpgradeKubernetesCluster(clusterId, version, nextVersion string) error {
+	args := map[string]string{
+		"component_name": "k8s",
+		"version":        version,
+		"next_version":   nextVersion,
+	}
+	return client.csconn.Invoke("", http.MethodPost, "/api/v2/clusters/"+clusterId+"/upgrade", nil, args, nil)
+}
+
+func (client *AliyunClient) InstallKubernetesAddons(clusterId string, addons []KubernetesAddon) error {
+	if len(addons) < 1 {
+		return nil
+	}
+	return client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/components/install", nil, addons, nil)
+}
+
+func (client *AliyunClient) UninstallKubernetesAddons(clusterId string, addons []KubernetesAddon) error {
+	if len(addons) < 1 {
+		return nil
+	}
+	return client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/components/uninstall", nil, addons, nil)
+}
+
+func (client *AliyunClient) ModifyKubernetesAddon(clusterId string, addon KubernetesAddon) error {
+	args := map[string]string{"config": addon.Config}
+	return client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/components/"+addon.Name+"/config", nil, args, nil)
+}
+
+// compareKubernetesVersion compares the major, minor, patch numbers and the '-aliyun.N' suffix of two kubernetes versions.
+// It returns a negative number when v1 is older than v2, a positive number when v1 is newer, otherwise zero.
+func compareKubernetesVersion(v1, v2 string) int {
+	parse := func(v string) []int {
+		var numbers []int
+		parts := strings.SplitN(v, "-", 2)
+		for _, n := range strings.Split(parts[0], DOT_SEPARATED) {
+			i, _ := strconv.Atoi(n)
+			numbers = append(numbers, i)
+		}
+		// The version without suffix is older than any of its aliyun patches.
+		suffix := 0
+		if len(parts) > 1 {
+			suffix, _ = strconv.Atoi(parts[1][strings.LastIndex(parts[1], DOT_SEPARATED)+1:])
+		}
+		return append(numbers, suffix)
+	}
+	n1, n2 := parse(v1), parse(v2)
+	for i := 0; i < len(n1) && i < len(n2); i++ {
+		if n1[i] != n2[i] {
+			return n1[i] - n2[i]
+		}
+	}
+	return len(n1) - len(n2)
+}
+
+// ContainerClusterAttachArgs is used to add the existing instances into a swarm or kubernetes cluster.
+type ContainerClusterAttachArgs struct {
+	Password   string   `json:"password,omitempty"`
+	KeyPair    string   `json:"key_pair,omitempty"`
+	Instances  []string `json:"instances"`
+	FormatDisk bool     `json:"format_disk"`
+}
+
+// KubernetesClusterNode adds the node attributes which are not in the SDK KubernetesNodeType.
+type KubernetesClusterNode struct {
+	cs.KubernetesNodeType
+	NodeName   string `json:"node_name"`
+	NodeStatus string `json:"node_status"`
+	State      string `json:"state"`
+	NodePoolId string `json:"nodepool_id"`
+	Source     string `json:"source"`
+}
+
+type SwarmClusterNode struct {
+	InstanceId string `json:"instance_id"`
+	Ip         string `json:"ip"`
+	Status     string `json:"status"`
+}
+
+func (client *AliyunClient) AttachContainerClusterNodes(clusterId string, args *ContainerClusterAttachArgs) error {
+	return client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/attach", nil, args, nil)
+}
+
+func (client *AliyunClient) DescribeKubernetesClusterNode(clusterId, instanceId string) (node KubernetesClusterNode, err error) {
+	var response struct {
+		Nodes []KubernetesClusterNode `json:"nodes"`
+	}
+	if err := client.csconn.Invoke("", http.MethodGet, "/clusters/"+clusterId+"/nodes?instanceIds="+instanceId, nil, nil, &response); err != nil {
+		if IsExceptedError(err, ErrorClusterNotFound) {
+			return node, GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Cluster Node", instanceId))
+		}
+		return node, fmt.Errorf("Describing kubernetes cluster node %s got an error: %#v.", instanceId, err)
+	}
+	for _, n := range response.Nodes {
+		if n.InstanceId == instanceId {
+			return n, nil
+		}
+	}
+	return node, GetNotFoundErrorFromString(GetNotFoundMessage("Kubernetes Cluster Node", instanceId))
+}
+
+// WaitForKubernetesClusterNodesRemoved waits for all of the nodes to be drained and removed from the cluster within the timeout.
+func (client *AliyunClient) WaitForKubernetesClusterNodesRemoved(clusterId string, instanceIds []string, timeout int) error {
+	return resource.Retry(time.Duration(timeout)*time.Second, func() *resource.RetryError {
+		var remaining []string
+		for _, id := range instanceIds {
+			if _, err := client.DescribeKubernetesClusterNode(clusterId, id); err != nil {
+				if NotFoundError(err) {
+					continue
+				}
+				return resource.NonRetryableError(err)
+			}
+			remaining = append(remaining, id)
+		}
+		if len(remaining) < 1 {
+			return nil
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Draining and removing Kubernetes Cluster Nodes %s timeout.", strings.Join(remaining, COMMA_SEPARATED)))
+	})
+}
+
+// WaitForKubernetesClusterNode waits for the node to report the status.
+// The node is not found for a while after attaching, so it is regarded as pending.
+func (client *AliyunClient) WaitForKubernetesClusterNode(clusterId, instanceId, status string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	for {
+		node, err := client.DescribeKubernetesClusterNode(clusterId, instanceId)
+		if err != nil && !NotFoundError(err) {
+			return err
+		}
+		if err == nil && node.NodeStatus == status {
+			break
+		}
+		timeout = timeout - DefaultIntervalShort
+		if timeout <= 0 {
+			return GetTimeErrorFromString(GetTimeoutMessage("Kubernetes Cluster Node", status))
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+	}
+	return nil
+}
+
+// RemoveKubernetesClusterNodes removes the nodes from the cluster. The nodes are drained before removing
+// when drain is true, and the ECS instances are released when release is true.
+func (client *AliyunClient) RemoveKubernetesClusterNodes(clusterId string, nodeNames []string, drain, release bool) error {
+	args := map[string]interface{}{
+		"nodes":        nodeNames,
+		"drain_node":   drain,
+		"release_node": release,
+	}
+	return client.csconn.Invoke("", http.MethodPost, "/clusters/"+clusterId+"/nodes/remove", nil, args, nil)
+}
+
+func (client *AliyunClient) DescribeSwarmClusterNode(clusterId, instanceId string) (node SwarmClusterNode, err error) {
+	var response struct {
+		Hosts []SwarmClusterNode `json:"hosts"`
+	}
+	if err := client.csconn.Invoke("", http.MethodGet, "/clusters/"+clusterId+"/hosts", nil, nil, &response); err != nil {
+		if IsExceptedError(err, ErrorClusterNotFound) {
+			return node, GetNotFoundErrorFromString(GetNotFoundMessage("Swarm Cluster Node", instanceId))
+		}
+		return node, fmt.Errorf("Describing swarm cluster node %s got an error: %#v.", instanceId, err)
+	}
+	for _, n := range response.Hosts {
+		if n.InstanceId == instanceId {
+			return n, nil
+		}
+	}
+	return node, GetNotFoundErrorFromString(GetNotFoundMessage("Swarm Cluster Node", instanceId))
+}
+
+// WaitForSwarmClusterNode waits for the node to report the status.
+// The node is not found for a while after attaching, so it is regarded as pending.
+func (client *AliyunClient) WaitForSwarmClusterNode(clusterId, instanceId, status string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	for {
+		node, err := client.DescribeSwarmClusterNode(clusterId, instanceId)
+		if err != nil && !NotFoundError(err) {
+			return err
+		}
+		if err == nil && node.Status == status {
+			break
+		}
+		timeout = timeout - DefaultIntervalShort
+		if timeout <= 0 {
+			return GetTimeErrorFromString(GetTimeoutMessage("Swarm Cluster Node", status))
+		}
+		time.Sleep(DefaultIntervalShort * time.Second)
+	}
+	return nil
+}
+
+func (client *AliyunClient) RemoveSwarmClusterNode(clusterId, ip string) error {
+	return client.csconn.Invoke("", http.MethodDelete, "/clusters/"+clusterId+"/ip/"+ip, nil, nil, nil)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ecs"
@@ -153,6 +154,61 @@ func (client *AliyunClient) ResourceAvailable(zone *ecs.ZoneType, resourceType e
 	return nil
 }
 
+// ReimageInstance replaces the system disk of the instance with the specified image and starts it again.
+// Nothing is done if the instance has been running the image.
+func (client *AliyunClient) ReimageInstance(instanceId, imageId string) error {
+	conn := client.ecsconn
+	instance, err := conn.DescribeInstanceAttribute(instanceId)
+	if err != nil {
+		return fmt.Errorf("Describe instance %s got an error: %#v", instanceId, err)
+	}
+	if instance.ImageId == imageId {
+		return nil
+	}
+
+	if instance.Status != ecs.Stopped {
+		if err := conn.StopInstance(instanceId, false); err != nil {
+			return fmt.Errorf("StopInstance got error: %#v", err)
+		}
+		if err := conn.WaitForInstanceAsyn(instanceId, ecs.Stopped, 300); err != nil {
+			return fmt.Errorf("WaitForInstance %s got error: %#v", ecs.Stopped, err)
+		}
+	}
+
+	if _, err := conn.ReplaceSystemDisk(&ecs.ReplaceSystemDiskArgs{
+		InstanceId: instanceId,
+		ImageId:    imageId,
+	}); err != nil {
+		return fmt.Errorf("Replace system disk got an error: %#v", err)
+	}
+
+	// Ensure instance's image has been replaced successfully.
+	timeout := ecs.InstanceDefaultTimeout
+	for {
+		instance, err := conn.DescribeInstanceAttribute(instanceId)
+		if err != nil {
+			return fmt.Errorf("Describe instance got an error: %#v", err)
+		}
+		if instance.ImageId == imageId {
+			break
+		}
+		timeout = timeout - ecs.DefaultWaitForInterval
+		if timeout <= 0 {
+			return GetTimeErrorFromString(GetTimeoutMessage("ECS Instance", "image "+imageId))
+		}
+		time.Sleep(ecs.DefaultWaitForInterval * time.Second)
+	}
+
+	if err := conn.StartInstance(instanceId); err != nil {
+		return fmt.Errorf("StartInstance got error: %#v", err)
+	}
+	// Start instance sometimes costs more than 8 minutes when os type is centos.
+	if err := conn.WaitForInstance(instanceId, ecs.Running, 500); err != nil {
+		return fmt.Errorf("WaitForInstance got error: %#v", err)
+	}
+	return nil
+}
+
 func (client *AliyunClient) DiskAvailable(zone *ecs.ZoneType, diskCategory ecs.DiskCategory) error {
 	available := false
 	for _, dist := range zone.AvailableDiskCategories.DiskCategories {
@@ -1,49 +1,152 @@
 package alicloud
 
 import (
+	"encoding/base64"
 	"fmt"
+	"math"
+	"net/url"
 	"time"
 
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/ess"
+	"github.com/denverdino/aliyungo/slb"
+	"github.com/denverdino/aliyungo/util"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
-func (client *AliyunClient) DescribeScalingGroupById(sgId string) (*ess.ScalingGroupItemType, error) {
+// EssCapacityPolicyArgs contains the multi-zone and on-demand capacity parameters which the SDK does not support yet.
+type EssCapacityPolicyArgs struct {
+	MultiAZPolicy                       string
+	OnDemandBaseCapacity                *int
+	OnDemandPercentageAboveBaseCapacity *int
+}
+
+type EssCreateScalingGroupArgs struct {
+	ess.CreateScalingGroupArgs
+	EssCapacityPolicyArgs
+}
+
+type EssModifyScalingGroupArgs struct {
+	ess.ModifyScalingGroupArgs
+	EssCapacityPolicyArgs
+}
+
+type EssScalingGroup struct {
+	ess.ScalingGroupItemType
+	MultiAZPolicy                       string
+	OnDemandBaseCapacity                int
+	OnDemandPercentageAboveBaseCapacity int
+}
+
+// EssInstancePolicyArgs contains the instance type priorities and spot parameters which the SDK does not support yet.
+type EssInstancePolicyArgs struct {
+	InstanceTypes  common.FlattenArray
+	SpotStrategy   string
+	SpotPriceLimit []EssSpotPriceLimit
+}
+
+type EssSpotPriceLimit struct {
+	InstanceType string
+	PriceLimit   float64
+}
+
+type EssCreateScalingConfigurationArgs struct {
+	ess.CreateScalingConfigurationArgs
+	EssInstancePolicyArgs
+}
+
+type EssScalingConfiguration struct {
+	ess.ScalingConfigurationItemType
+	InstanceTypes struct {
+		InstanceType []string
+	}
+	SpotStrategy   string
+	SpotPriceLimit struct {
+		SpotPriceModel []EssSpotPriceLimit
+	}
+}
+
+// flattenEssQuery flattens the SDK args and its extension one by one, because an embedded struct
+// loses the flattened parameters, like VSwitchIds.N and SystemDisk.Category, when it is encoded.
+func flattenEssQuery(args ...interface{}) url.Values {
+	query := url.Values{}
+	for _, arg := range args {
+		util.SetQueryValueByFlattenMethod(arg, &query)
+	}
+	return query
+}
+
+func (client *AliyunClient) CreateEssScalingGroup(args *EssCreateScalingGroupArgs) (*ess.CreateScalingGroupResponse, error) {
+	response := ess.CreateScalingGroupResponse{}
+	query := flattenEssQuery(&args.CreateScalingGroupArgs, &args.EssCapacityPolicyArgs)
+	if err := client.essconn.InvokeByFlattenMethod("CreateScalingGroup", query, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (client *AliyunClient) ModifyEssScalingGroup(args *EssModifyScalingGroupArgs) error {
+	query := flattenEssQuery(&args.ModifyScalingGroupArgs, &args.EssCapacityPolicyArgs)
+	return client.essconn.InvokeByFlattenMethod("ModifyScalingGroup", query, &ess.ModifyScalingGroupResponse{})
+}
+
+func (client *AliyunClient) DescribeScalingGroupById(sgId string) (*EssScalingGroup, error) {
 	args := ess.DescribeScalingGroupsArgs{
 		RegionId:       client.Region,
 		ScalingGroupId: []string{sgId},
 	}
 
-	sgs, _, err := client.essconn.DescribeScalingGroups(&args)
-	if err != nil {
+	var response struct {
+		common.Response
+		ScalingGroups struct {
+			ScalingGroup []EssScalingGroup
+		}
+	}
+	if err := client.essconn.InvokeByFlattenMethod("DescribeScalingGroups", &args, &response); err != nil {
 		return nil, err
 	}
 
-	if len(sgs) == 0 {
+	if len(response.ScalingGroups.ScalingGroup) == 0 {
 		return nil, GetNotFoundErrorFromString("Scaling group not found")
 	}
 
-	return &sgs[0], nil
+	return &response.ScalingGroups.ScalingGroup[0], nil
+}
+
+func (client *AliyunClient) CreateEssScalingConfiguration(args *EssCreateScalingConfigurationArgs) (*ess.CreateScalingConfigurationResponse, error) {
+	if args.UserData != "" {
+		args.UserData = base64.StdEncoding.EncodeToString([]byte(args.UserData))
+	}
+	response := ess.CreateScalingConfigurationResponse{}
+	query := flattenEssQuery(&args.CreateScalingConfigurationArgs, &args.EssInstancePolicyArgs)
+	if err := client.essconn.InvokeByFlattenMethod("CreateScalingConfiguration", query, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
 }
 
-func (client *AliyunClient) DescribeScalingConfigurationById(configId string) (*ess.ScalingConfigurationItemType, error) {
+func (client *AliyunClient) DescribeScalingConfigurationById(configId string) (*EssScalingConfiguration, error) {
 	args := ess.DescribeScalingConfigurationsArgs{
 		RegionId: client.Region,
 		//ScalingGroupId:         sgId,
 		ScalingConfigurationId: []string{configId},
 	}
 
-	cs, _, err := client.essconn.DescribeScalingConfigurations(&args)
-	if err != nil {
+	var response struct {
+		common.Response
+		ScalingConfigurations struct {
+			ScalingConfiguration []EssScalingConfiguration
+		}
+	}
+	if err := client.essconn.InvokeByFlattenMethod("DescribeScalingConfigurations", &args, &response); err != nil {
 		return nil, err
 	}
 
-	if len(cs) == 0 {
+	if len(response.ScalingConfigurations.ScalingConfiguration) == 0 {
 		return nil, GetNotFoundErrorFromString("Scaling configuration not found")
 	}
 
-	return &cs[0], nil
+	return &response.ScalingConfigurations.ScalingConfiguration[0], nil
 }
 
 func (client *AliyunClient) ActiveScalingConfigurationById(sgId, configId string) error {
@@ -71,23 +174,82 @@ func flattenDataDiskMappings(list []ess.DataDiskItemType) []map[string]interface
 	return result
 }
 
-func (client *AliyunClient) DescribeScalingRuleById(sgId, ruleId string) (*ess.ScalingRuleItemType, error) {
+// EssScalingRulePolicy contains the target tracking and step scaling parameters which the SDK does not support yet.
+type EssScalingRulePolicy struct {
+	ScalingRuleType         string
+	MetricName              string
+	TargetValue             string
+	DisableScaleIn          string
+	EstimatedInstanceWarmup int
+	StepAdjustment          []EssStepAdjustment
+}
+
+type EssStepAdjustment struct {
+	MetricIntervalLowerBound string
+	MetricIntervalUpperBound string
+	ScalingAdjustment        int
+}
+
+type EssCreateScalingRuleArgs struct {
+	ess.CreateScalingRuleArgs
+	EssScalingRulePolicy
+}
+
+type EssModifyScalingRuleArgs struct {
+	ess.ModifyScalingRuleArgs
+	EssScalingRulePolicy
+}
+
+type EssScalingRule struct {
+	ess.ScalingRuleItemType
+	ScalingRuleType         string
+	MetricName              string
+	TargetValue             float64
+	DisableScaleIn          bool
+	EstimatedInstanceWarmup int
+	StepAdjustments         struct {
+		StepAdjustment []struct {
+			MetricIntervalLowerBound *float64
+			MetricIntervalUpperBound *float64
+			ScalingAdjustment        int
+		}
+	}
+}
+
+func (client *AliyunClient) CreateEssScalingRule(args *EssCreateScalingRuleArgs) (*ess.CreateScalingRuleResponse, error) {
+	response := ess.CreateScalingRuleResponse{}
+	if err := client.essconn.Invoke("CreateScalingRule", args, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (client *AliyunClient) ModifyEssScalingRule(args *EssModifyScalingRuleArgs) error {
+	return client.essconn.Invoke("ModifyScalingRule", args, &ess.ModifyScalingRuleResponse{})
+}
+
+func (client *AliyunClient) DescribeScalingRuleById(sgId, ruleId string) (*EssScalingRule, error) {
 	args := ess.DescribeScalingRulesArgs{
 		RegionId:       client.Region,
 		ScalingGroupId: sgId,
 		ScalingRuleId:  []string{ruleId},
 	}
 
-	cs, _, err := client.essconn.DescribeScalingRules(&args)
-	if err != nil {
+	var response struct {
+		common.Response
+		ScalingRules struct {
+			ScalingRule []EssScalingRule
+		}
+	}
+	if err := client.essconn.InvokeByFlattenMethod("DescribeScalingRules", &args, &response); err != nil {
 		return nil, err
 	}
 
-	if len(cs) == 0 {
+	if len(response.ScalingRules.ScalingRule) == 0 {
 		return nil, GetNotFoundErrorFromString("Scaling rule not found")
 	}
 
-	return &cs[0], nil
+	return &response.ScalingRules.ScalingRule[0], nil
 }
 
 func (client *AliyunClient) DeleteScalingRuleById(ruleId string) error {
@@ -217,3 +379,615 @@ func (client *AliyunClient) EssRemoveInstances(groupId string, instanceIds []str
 		return nil
 	})
 }
+
+func (client *AliyunClient) DescribeEssInServiceInstances(groupId string) (instances []ess.ScalingInstanceItemType, err error) {
+	args := ess.DescribeScalingInstancesArgs{
+		RegionId:       client.Region,
+		ScalingGroupId: groupId,
+		LifecycleState: ess.InService,
+		Pagination:     getPagination(1, 50),
+	}
+
+	for {
+		results, _, err := client.essconn.DescribeScalingInstances(&args)
+		if err != nil {
+			return nil, fmt.Errorf("DescribeScalingInstances got an error: %#v", err)
+		}
+		instances = append(instances, results...)
+		if len(results) < args.PageSize {
+			break
+		}
+		args.PageNumber += 1
+	}
+	return
+}
+
+// DescribeEssOutdatedInstances returns the in-service instances created by a scaling configuration other than the active one.
+func (client *AliyunClient) DescribeEssOutdatedInstances(group *EssScalingGroup) (instanceIds []string, err error) {
+	instances, err := client.DescribeEssInServiceInstances(group.ScalingGroupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range instances {
+		if inst.CreationType == EssAutoCreatedInstance && inst.ScalingConfigurationId != group.ActiveScalingConfigurationId {
+			instanceIds = append(instanceIds, inst.InstanceId)
+		}
+	}
+	return
+}
+
+// WaitForEssScalingGroupCapacity waits for the scaling group to have the expected in-service instances without pending and removing ones.
+func (client *AliyunClient) WaitForEssScalingGroupCapacity(groupId string, capacity, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	for {
+		group, err := client.DescribeScalingGroupById(groupId)
+		if err != nil {
+			return err
+		}
+		if group.ActiveCapacity == capacity && group.PendingCapacity == 0 && group.RemovingCapacity == 0 {
+			break
+		}
+
+		if timeout <= 0 {
+			return common.GetClientErrorFromString("Timeout")
+		}
+
+		timeout = timeout - DefaultIntervalMedium
+		time.Sleep(DefaultIntervalMedium * time.Second)
+	}
+	return nil
+}
+
+// essInstanceRefreshBatch returns the number of outdated instances to replace in the next batch. When the max size
+// leaves room for them, the replacements are launched before removing the outdated instances (surge is true).
+// Otherwise the outdated instances are removed first while keeping the min healthy percentage of the in-service
+// instances, but at least one instance is replaced in each batch so that the refresh always makes progress.
+func essInstanceRefreshBatch(total, outdated, maxSize, percentage, batchSize int) (batch int, surge bool) {
+	batch = batchSize
+	if batch > outdated {
+		batch = outdated
+	}
+	if room := maxSize - total; room > 0 {
+		if batch > room {
+			batch = room
+		}
+		return batch, true
+	}
+
+	if healthy := total - int(math.Ceil(float64(total*percentage)/100)); batch > healthy {
+		batch = healthy
+	}
+	if batch < 1 {
+		batch = 1
+	}
+	return batch, false
+}
+
+// RefreshEssScalingGroupInstances replaces the instances created by the former scaling configurations batch by batch,
+// and waits for the new instances to be healthy in the load balancers before the next batch. The min size of the
+// scaling group is changed temporarily to launch and remove the instances, and it is restored at last.
+// It returns a timeout error when the instances are not all replaced in timeout seconds.
+func (client *AliyunClient) RefreshEssScalingGroupInstances(groupId string, percentage, batchSize, pause, timeout int) (err error) {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	remaining := func() int {
+		return int(time.Until(deadline).Seconds())
+	}
+	timeoutErr := func() error {
+		return fmt.Errorf("Refreshing instances of scaling group %s got an error: %#v", groupId, common.GetClientErrorFromString("Timeout"))
+	}
+
+	group, err := client.DescribeScalingGroupById(groupId)
+	if err != nil {
+		return fmt.Errorf("DescribeScalingGroupById %s error: %#v", groupId, err)
+	}
+
+	minSize := group.MinSize
+	setMinSize := func(size int) error {
+		args := &EssModifyScalingGroupArgs{}
+		args.ScalingGroupId = groupId
+		args.MinSize = &size
+		if err := client.ModifyEssScalingGroup(args); err != nil {
+			return fmt.Errorf("Modifying min size of scaling group %s to %d got an error: %#v", groupId, size, err)
+		}
+		return nil
+	}
+	defer func() {
+		if e := setMinSize(minSize); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	waitFor := func(capacity int) error {
+		if remaining() <= 0 {
+			return timeoutErr()
+		}
+		if err := client.WaitForEssScalingGroupCapacity(groupId, capacity, remaining()); err != nil {
+			return fmt.Errorf("Waitting for scaling group %s to have %d instances got an error: %#v", groupId, capacity, err)
+		}
+		if remaining() <= 0 {
+			return timeoutErr()
+		}
+		if err := client.WaitForEssInstancesHealthy(groupId, remaining()); err != nil {
+			return fmt.Errorf("Waitting for instances of scaling group %s to be healthy got an error: %#v", groupId, err)
+		}
+		return nil
+	}
+
+	for {
+		group, err := client.DescribeScalingGroupById(groupId)
+		if err != nil {
+			return fmt.Errorf("DescribeScalingGroupById %s error: %#v", groupId, err)
+		}
+		if group.LifecycleState != ess.Active {
+			return nil
+		}
+		outdated, err := client.DescribeEssOutdatedInstances(group)
+		if err != nil {
+			return err
+		}
+		if len(outdated) < 1 {
+			return nil
+		}
+		if remaining() <= 0 {
+			return timeoutErr()
+		}
+
+		total := group.ActiveCapacity
+		batch, surge := essInstanceRefreshBatch(total, len(outdated), group.MaxSize, percentage, batchSize)
+		if surge {
+			if err := setMinSize(total + batch); err != nil {
+				return err
+			}
+			if err := waitFor(total + batch); err != nil {
+				return err
+			}
+			if err := setMinSize(total); err != nil {
+				return err
+			}
+			if err := client.EssRemoveInstances(groupId, outdated[:batch]); err != nil {
+				return err
+			}
+			if remaining() <= 0 {
+				return timeoutErr()
+			}
+			if err := client.WaitForEssScalingGroupCapacity(groupId, total, remaining()); err != nil {
+				return fmt.Errorf("Waitting for scaling group %s to remove %d instances got an error: %#v", groupId, batch, err)
+			}
+		} else {
+			if group.MinSize > total-batch {
+				if err := setMinSize(total - batch); err != nil {
+					return err
+				}
+			}
+			if err := client.EssRemoveInstances(groupId, outdated[:batch]); err != nil {
+				return err
+			}
+			if err := setMinSize(total); err != nil {
+				return err
+			}
+			if err := waitFor(total); err != nil {
+				return err
+			}
+		}
+
+		if pause > 0 {
+			time.Sleep(time.Duration(pause) * time.Second)
+		}
+	}
+}
+
+// WaitForEssInstancesHealthy waits for all of the in-service instances of the scaling group to be healthy in its load balancers.
+func (client *AliyunClient) WaitForEssInstancesHealthy(groupId string, timeout int) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	for {
+		group, err := client.DescribeScalingGroupById(groupId)
+		if err != nil {
+			return err
+		}
+		instances, err := client.DescribeEssInServiceInstances(groupId)
+		if err != nil {
+			return err
+		}
+
+		healthy := true
+		for _, lb := range group.LoadBalancerIds.LoadBalancerId {
+			resp, err := client.slbconn.DescribeHealthStatus(&slb.DescribeHealthStatusArgs{
+				LoadBalancerId: lb,
+			})
+			if err != nil {
+				return fmt.Errorf("DescribeHealthStatus %s got an error: %#v", lb, err)
+			}
+			status := make(map[string]bool)
+			for _, server := range resp.BackendServers.BackendServer {
+				if _, ok := status[server.ServerId]; !ok {
+					status[server.ServerId] = true
+				}
+				status[server.ServerId] = status[server.ServerId] && server.ServerHealthStatus == BackendServerHealthNormal
+			}
+			for _, inst := range instances {
+				if !status[inst.InstanceId] {
+					healthy = false
+				}
+			}
+		}
+		if healthy {
+			break
+		}
+
+		if timeout <= 0 {
+			return common.GetClientErrorFromString("Timeout")
+		}
+
+		timeout = timeout - DefaultIntervalMedium
+		time.Sleep(DefaultIntervalMedium * time.Second)
+	}
+	return nil
+}
+
+// The ESS SDK does not support lifecycle hooks and notifications yet, so the following APIs are invoked by the common client.
+type EssLifecycleHookArgs struct {
+	RegionId             common.Region
+	ScalingGroupId       string
+	LifecycleHookId      string
+	LifecycleHookName    string
+	LifecycleTransition  LifecycleTransition
+	HeartbeatTimeout     int
+	DefaultResult        LifecycleActionResult
+	NotificationArn      string
+	NotificationMetadata string
+}
+
+type EssLifecycleHook struct {
+	ScalingGroupId       string
+	LifecycleHookId      string
+	LifecycleHookName    string
+	LifecycleTransition  LifecycleTransition
+	HeartbeatTimeout     int
+	DefaultResult        LifecycleActionResult
+	NotificationArn      string
+	NotificationMetadata string
+}
+
+type EssLifecycleAction struct {
+	LifecycleHookId       string
+	LifecycleActionToken  string
+	LifecycleActionStatus string
+	InstanceIds           struct {
+		InstanceId []string
+	}
+}
+
+type EssNotificationArgs struct {
+	RegionId         common.Region
+	ScalingGroupId   string
+	NotificationArn  string
+	NotificationType []string `query:"list"`
+}
+
+type EssNotification struct {
+	ScalingGroupId    string
+	NotificationArn   string
+	NotificationTypes struct {
+		NotificationType []string
+	}
+}
+
+func (client *AliyunClient) CreateEssLifecycleHook(args *EssLifecycleHookArgs) (string, error) {
+	var response struct {
+		common.Response
+		LifecycleHookId string
+	}
+	if err := client.essconn.Invoke("CreateLifecycleHook", args, &response); err != nil {
+		return "", err
+	}
+	return response.LifecycleHookId, nil
+}
+
+func (client *AliyunClient) ModifyEssLifecycleHook(args *EssLifecycleHookArgs) error {
+	return client.essconn.Invoke("ModifyLifecycleHook", args, &common.Response{})
+}
+
+func (client *AliyunClient) DescribeEssLifecycleHookById(hookId string) (hook EssLifecycleHook, err error) {
+	args := struct {
+		RegionId        common.Region
+		LifecycleHookId []string `query:"list"`
+	}{
+		RegionId:        client.Region,
+		LifecycleHookId: []string{hookId},
+	}
+	var response struct {
+		common.Response
+		LifecycleHooks struct {
+			LifecycleHook []EssLifecycleHook
+		}
+	}
+	if err := client.essconn.Invoke("DescribeLifecycleHooks", &args, &response); err != nil {
+		if IsExceptedError(err, InvalidLifecycleHookIdNotExist) || IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+			return hook, GetNotFoundErrorFromString(GetNotFoundMessage("Lifecycle Hook", hookId))
+		}
+		return hook, err
+	}
+	for _, h := range response.LifecycleHooks.LifecycleHook {
+		if h.LifecycleHookId == hookId {
+			return h, nil
+		}
+	}
+	return hook, GetNotFoundErrorFromString(GetNotFoundMessage("Lifecycle Hook", hookId))
+}
+
+func (client *AliyunClient) DeleteEssLifecycleHookById(hookId string) error {
+	args := struct {
+		RegionId        common.Region
+		LifecycleHookId string
+	}{
+		RegionId:        client.Region,
+		LifecycleHookId: hookId,
+	}
+	return client.essconn.Invoke("DeleteLifecycleHook", &args, &common.Response{})
+}
+
+// DescribeEssPendingLifecycleActions returns the pending actions of the lifecycle hook in all of the running scaling activities.
+func (client *AliyunClient) DescribeEssPendingLifecycleActions(scalingGroupId, hookId string) (actions []EssLifecycleAction, err error) {
+	args := struct {
+		RegionId       common.Region
+		ScalingGroupId string
+		StatusCode     string
+		PageNumber     int
+		PageSize       int
+	}{
+		RegionId:       client.Region,
+		ScalingGroupId: scalingGroupId,
+		StatusCode:     "InProgress",
+		PageNumber:     1,
+		PageSize:       50,
+	}
+	var activityIds []string
+	for {
+		var activities struct {
+			common.Response
+			ScalingActivities struct {
+				ScalingActivity []struct {
+					ScalingActivityId string
+				}
+			}
+		}
+		if err := client.essconn.Invoke("DescribeScalingActivities", &args, &activities); err != nil {
+			return nil, fmt.Errorf("DescribeScalingActivities got an error: %#v", err)
+		}
+		for _, activity := range activities.ScalingActivities.ScalingActivity {
+			activityIds = append(activityIds, activity.ScalingActivityId)
+		}
+		if len(activities.ScalingActivities.ScalingActivity) < args.PageSize {
+			break
+		}
+		args.PageNumber += 1
+	}
+
+	for _, activityId := range activityIds {
+		args := struct {
+			RegionId              common.Region
+			ScalingActivityId     string
+			LifecycleActionStatus string
+		}{
+			RegionId:              client.Region,
+			ScalingActivityId:     activityId,
+			LifecycleActionStatus: "Pending",
+		}
+		var response struct {
+			common.Response
+			LifecycleActions struct {
+				LifecycleAction []EssLifecycleAction
+			}
+		}
+		if err := client.essconn.Invoke("DescribeLifecycleActions", &args, &response); err != nil {
+			return nil, fmt.Errorf("DescribeLifecycleActions got an error: %#v", err)
+		}
+		for _, action := range response.LifecycleActions.LifecycleAction {
+			if action.LifecycleHookId == hookId {
+				actions = append(actions, action)
+			}
+		}
+	}
+	return
+}
+
+// EssCompleteLifecycleActions completes the pending lifecycle actions which contain the instances,
+// so that the scaling activities go on without waiting for the heartbeat timeout.
+func (client *AliyunClient) EssCompleteLifecycleActions(hookId string, instanceIds []string, result LifecycleActionResult) error {
+	hook, err := client.DescribeEssLifecycleHookById(hookId)
+	if err != nil {
+		return err
+	}
+	actions, err := client.DescribeEssPendingLifecycleActions(hook.ScalingGroupId, hookId)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		matched := false
+		for _, id := range action.InstanceIds.InstanceId {
+			for _, instanceId := range instanceIds {
+				if id == instanceId {
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			continue
+		}
+		args := struct {
+			RegionId              common.Region
+			LifecycleHookId       string
+			LifecycleActionToken  string
+			LifecycleActionResult LifecycleActionResult
+		}{
+			RegionId:              client.Region,
+			LifecycleHookId:       hookId,
+			LifecycleActionToken:  action.LifecycleActionToken,
+			LifecycleActionResult: result,
+		}
+		if err := client.essconn.Invoke("CompleteLifecycleAction", &args, &common.Response{}); err != nil {
+			return fmt.Errorf("CompleteLifecycleAction %s got an error: %#v", action.LifecycleActionToken, err)
+		}
+	}
+	return nil
+}
+
+func (client *AliyunClient) CreateEssNotification(args *EssNotificationArgs) error {
+	return client.essconn.Invoke("CreateNotificationConfiguration", args, &common.Response{})
+}
+
+func (client *AliyunClient) ModifyEssNotification(args *EssNotificationArgs) error {
+	return client.essconn.Invoke("ModifyNotificationConfiguration", args, &common.Response{})
+}
+
+func (client *AliyunClient) DescribeEssNotification(scalingGroupId, notificationArn string) (notification EssNotification, err error) {
+	args := struct {
+		RegionId       common.Region
+		ScalingGroupId string
+	}{
+		RegionId:       client.Region,
+		ScalingGroupId: scalingGroupId,
+	}
+	var response struct {
+		common.Response
+		NotificationConfigurationModels struct {
+			NotificationConfigurationModel []EssNotification
+		}
+	}
+	if err := client.essconn.Invoke("DescribeNotificationConfigurations", &args, &response); err != nil {
+		if IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+			return notification, GetNotFoundErrorFromString(GetNotFoundMessage("Ess Notification", notificationArn))
+		}
+		return notification, err
+	}
+	for _, n := range response.NotificationConfigurationModels.NotificationConfigurationModel {
+		if n.NotificationArn == notificationArn {
+			return n, nil
+		}
+	}
+	return notification, GetNotFoundErrorFromString(GetNotFoundMessage("Ess Notification", notificationArn))
+}
+
+func (client *AliyunClient) DeleteEssNotification(scalingGroupId, notificationArn string) error {
+	args := &EssNotificationArgs{
+		RegionId:        client.Region,
+		ScalingGroupId:  scalingGroupId,
+		NotificationArn: notificationArn,
+	}
+	return client.essconn.Invoke("DeleteNotificationConfiguration", args, &common.Response{})
+}
+
+type EssAlarmArgs struct {
+	RegionId           common.Region
+	AlarmTaskId        string
+	ScalingGroupId     string
+	Name               string
+	Description        string
+	AlarmAction        []string `query:"list"`
+	MetricType         string
+	MetricName         string
+	Period             int
+	Statistics         string
+	Threshold          string
+	ComparisonOperator string
+	EvaluationCount    int
+	Dimension          []EssAlarmDimension
+}
+
+type EssAlarmDimension struct {
+	DimensionKey   string
+	DimensionValue string
+}
+
+type EssAlarm struct {
+	AlarmTaskId        string
+	ScalingGroupId     string
+	Name               string
+	Description        string
+	MetricType         string
+	MetricName         string
+	Period             int
+	Statistics         string
+	Threshold          float64
+	ComparisonOperator string
+	EvaluationCount    int
+	State              string
+	Enable             bool
+	AlarmActions       struct {
+		AlarmAction []string
+	}
+	Dimensions struct {
+		Dimension []EssAlarmDimension
+	}
+}
+
+func (client *AliyunClient) CreateEssAlarm(args *EssAlarmArgs) (string, error) {
+	var response struct {
+		common.Response
+		AlarmTaskId string
+	}
+	if err := client.essconn.Invoke("CreateAlarm", args, &response); err != nil {
+		return "", err
+	}
+	return response.AlarmTaskId, nil
+}
+
+func (client *AliyunClient) ModifyEssAlarm(args *EssAlarmArgs) error {
+	return client.essconn.Invoke("ModifyAlarm", args, &common.Response{})
+}
+
+func (client *AliyunClient) DescribeEssAlarmById(alarmId string) (alarm EssAlarm, err error) {
+	args := struct {
+		RegionId    common.Region
+		AlarmTaskId string
+	}{
+		RegionId:    client.Region,
+		AlarmTaskId: alarmId,
+	}
+	var response struct {
+		common.Response
+		AlarmList struct {
+			Alarm []EssAlarm
+		}
+	}
+	if err := client.essconn.Invoke("DescribeAlarms", &args, &response); err != nil {
+		return alarm, err
+	}
+	for _, a := range response.AlarmList.Alarm {
+		if a.AlarmTaskId == alarmId {
+			return a, nil
+		}
+	}
+	return alarm, GetNotFoundErrorFromString(GetNotFoundMessage("Ess Alarm", alarmId))
+}
+
+func (client *AliyunClient) SetEssAlarmEnabled(alarmId string, enabled bool) error {
+	args := struct {
+		RegionId    common.Region
+		AlarmTaskId string
+	}{
+		RegionId:    client.Region,
+		AlarmTaskId: alarmId,
+	}
+	action := "DisableAlarm"
+	if enabled {
+		action = "EnableAlarm"
+	}
+	return client.essconn.Invoke(action, &args, &common.Response{})
+}
+
+func (client *AliyunClient) DeleteEssAlarmById(alarmId string) error {
+	args := struct {
+		RegionId    common.Region
+		AlarmTaskId string
+	}{
+		RegionId:    client.Region,
+		AlarmTaskId: alarmId,
+	}
+	return client.essconn.Invoke("DeleteAlarm", &args, &common.Response{})
+}
@@ -0,0 +1,565 @@
+package alicloud
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// buildFcFunctionCode builds the function code from one and only one of the sources:
+// the local 'code', the OSS object 'oss_bucket' and 'oss_key', or the inline 'filename' and 'content'.
+func buildFcFunctionCode(d *schema.ResourceData) (*fc.Code, error) {
+	code, codeOk := d.GetOk("code")
+	bucket, bucketOk := d.GetOk("oss_bucket")
+	key, keyOk := d.GetOk("oss_key")
+	filename, filenameOk := d.GetOk("filename")
+	content, contentOk := d.GetOk("content")
+
+	if bucketOk != keyOk {
+		return nil, fmt.Errorf("'oss_bucket' and 'oss_key' must be specified together.")
+	}
+	if filenameOk != contentOk {
+		return nil, fmt.Errorf("'filename' and 'content' must be specified together.")
+	}
+
+	sources := 0
+	for _, ok := range []bool{codeOk, bucketOk, filenameOk} {
+		if ok {
+			sources++
+		}
+	}
+	if sources == 0 && d.Get("runtime").(string) == FcRuntimeCustomContainer {
+		// The code of a custom container function is the image in 'custom_container_config'.
+		return nil, nil
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("One and only one of 'code', 'oss_bucket' with 'oss_key', and 'filename' with 'content' must be specified.")
+	}
+
+	if bucketOk {
+		return fc.NewCode().WithOSSBucketName(bucket.(string)).WithOSSObjectName(key.(string)), nil
+	}
+
+	var zipFile []byte
+	var err error
+	if codeOk {
+		zipFile, err = loadFcFunctionCode(code.(string))
+	} else {
+		zipFile, err = zipFcCodeContent(filename.(string), content.(string))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fc.NewCode().WithZipFile(zipFile), nil
+}
+
+// fcFunctionCodeChanged reports whether any of the code sources has been changed.
+func fcFunctionCodeChanged(d *schema.ResourceData) bool {
+	for _, key := range []string{"code", "oss_bucket", "oss_key", "filename", "content"} {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// fcCodeSourceHash is the state function of the local 'code'. The SHA-256 of the code is stored instead of its path,
+// so changing the contents of the zip file or the directory triggers an update.
+func fcCodeSourceHash(v interface{}) string {
+	code, ok := v.(string)
+	if !ok || code == "" {
+		return ""
+	}
+	hash, err := hashFcCodeSource(code)
+	if err != nil {
+		// The path is kept, and the error is reported when the code is loaded.
+		return code
+	}
+	return hash
+}
+
+// hashFcCodeSource hashes the zip or jar file, or the relative paths and contents of the files in the directory in
+// sorted path order. It does not depend on how the directory is zipped, which can change with the Go version.
+func hashFcCodeSource(code string) (string, error) {
+	info, err := os.Stat(code)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	if !info.IsDir() {
+		content, err := ioutil.ReadFile(code)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(content)
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	files := make(map[string]os.FileInfo)
+	var paths []string
+	err = filepath.Walk(code, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(code, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relPath)] = info
+		paths = append(paths, filepath.ToSlash(relPath))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		var content []byte
+		if files[path].Mode()&os.ModeSymlink != 0 {
+			dest, err := os.Readlink(filepath.Join(code, path))
+			if err != nil {
+				return "", err
+			}
+			content = []byte(dest)
+		} else if content, err = ioutil.ReadFile(filepath.Join(code, path)); err != nil {
+			return "", err
+		}
+		fmt.Fprintf(hash, "%s\x00%d\x00", path, len(content))
+		hash.Write(content)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// loadFcFunctionCode returns the zip content of the function code. The code can be a zip or jar file,
+// or a directory which will be zipped in a deterministic way, so its checksum only depends on the file contents.
+func loadFcFunctionCode(code string) ([]byte, error) {
+	info, err := os.Stat(code)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("File or directory %s does not exist.", code)
+		}
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if !strings.HasSuffix(code, ".zip") && !strings.HasSuffix(code, ".jar") {
+			return nil, fmt.Errorf("The code %s must be a directory, a zip file or a jar file.", code)
+		}
+		return ioutil.ReadFile(code)
+	}
+
+	return zipFcCodeDir(code)
+}
+
+// zipFcCodeDir zips the directory without the modified time of the files, and filepath.Walk
+// walks the files in lexical order, so the same contents always result in the same zip file.
+func zipFcCodeDir(dir string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:   filepath.ToSlash(relPath),
+			Method: zip.Deflate,
+		}
+		header.SetMode(info.Mode())
+		if info.IsDir() {
+			header.Name += "/"
+			header.Method = zip.Store
+		}
+
+		w, err := writer.CreateHeader(header)
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			dest, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write([]byte(dest))
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(w, file)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// zipFcCodeContent zips the inline content as a single file in memory.
+func zipFcCodeContent(filename, content string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+
+	header := &zip.FileHeader{
+		Name:   filename,
+		Method: zip.Deflate,
+	}
+	header.SetMode(0644)
+
+	w, err := writer.CreateHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write([]byte(content)); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FcCommonRequest is a request of the function compute API which is not supported by the vendored fc-go-sdk,
+// like versions, aliases and provision configs. It implements the interface fc.ServiceInput.
+type FcCommonRequest struct {
+	Path        string
+	QueryParams url.Values
+	Headers     fc.Header
+	Payload     interface{}
+}
+
+// NewFcCommonRequest returns a request whose path is formatted with the escaped arguments.
+func NewFcCommonRequest(format string, a ...interface{}) *FcCommonRequest {
+	for i, v := range a {
+		if s, ok := v.(string); ok {
+			a[i] = url.PathEscape(s)
+		}
+	}
+	return &FcCommonRequest{
+		Path:        fmt.Sprintf(format, a...),
+		QueryParams: make(url.Values),
+		Headers:     make(fc.Header),
+	}
+}
+
+func (r *FcCommonRequest) GetQueryParams() url.Values {
+	return r.QueryParams
+}
+
+func (r *FcCommonRequest) GetPath() string {
+	return r.Path
+}
+
+func (r *FcCommonRequest) GetHeaders() fc.Header {
+	return r.Headers
+}
+
+func (r *FcCommonRequest) GetPayload() interface{} {
+	return r.Payload
+}
+
+func (r *FcCommonRequest) Validate() error {
+	if r.Path == "" {
+		return fmt.Errorf("The path of function compute request is required but not provided.")
+	}
+	return nil
+}
+
+// ProcessFcCommonRequest signs and sends the request in the same way as the fc-go-sdk,
+// and decodes the response body into the output if it is not nil.
+func (client *AliyunClient) ProcessFcCommonRequest(method string, request *FcCommonRequest, output interface{}) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	config := client.fcconn.Config
+	endpoint, host := fc.GetAccessPoint(config.Endpoint)
+	path := "/" + config.APIVersion + request.GetPath()
+
+	headers := make(map[string]string)
+	for k, v := range request.GetHeaders() {
+		headers[k] = v
+	}
+	headers["Host"] = host
+	headers[fc.HTTPHeaderAccountID] = config.AccountID
+	headers[fc.HTTPHeaderUserAgent] = config.UserAgent
+	headers["Accept"] = "application/json"
+
+	var body interface{}
+	if request.GetPayload() != nil {
+		b, err := json.Marshal(request.GetPayload())
+		if err != nil {
+			return err
+		}
+		headers[fc.HTTPHeaderContentType] = "application/json"
+		headers[fc.HTTPHeaderContentMD5] = fc.MD5(b)
+		body = b
+	}
+	headers["Date"] = time.Now().UTC().Format(http.TimeFormat)
+	if config.SecurityToken != "" {
+		headers[fc.HTTPHeaderSecurityToken] = config.SecurityToken
+	}
+	headers["Authorization"] = fc.GetAuthStr(config.AccessKeyID, config.AccessKeySecret, method, headers, path)
+
+	resp, err := client.fcconn.Connect.SendRequest(endpoint+path, method, body, headers, request.GetQueryParams())
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() >= 300 {
+		serviceError := &fc.ServiceError{
+			HTTPStatus: resp.StatusCode(),
+			RequestID:  resp.Header().Get(fc.HTTPHeaderRequestID),
+		}
+		json.Unmarshal(resp.Body(), serviceError)
+		return serviceError
+	}
+
+	if output != nil && len(resp.Body()) > 0 {
+		return json.Unmarshal(resp.Body(), output)
+	}
+	return nil
+}
+
+type FcCustomContainerConfig struct {
+	Image   string `json:"image"`
+	Command string `json:"command,omitempty"`
+	Args    string `json:"args,omitempty"`
+}
+
+// FcFunctionRuntimeConfig holds the function settings which are not supported by the vendored fc-go-sdk.
+// A nil field is sent as null, which leaves the setting unchanged on updating.
+type FcFunctionRuntimeConfig struct {
+	Initializer           *string                  `json:"initializer"`
+	InitializationTimeout *int32                   `json:"initializationTimeout"`
+	InstanceConcurrency   *int32                   `json:"instanceConcurrency"`
+	CAPort                *int32                   `json:"caPort"`
+	CustomContainerConfig *FcCustomContainerConfig `json:"customContainerConfig"`
+	Layers                []string                 `json:"layers"`
+}
+
+type FcFunction struct {
+	FunctionId           string            `json:"functionId"`
+	FunctionName         string            `json:"functionName"`
+	Description          string            `json:"description"`
+	Runtime              string            `json:"runtime"`
+	Handler              string            `json:"handler"`
+	Timeout              int32             `json:"timeout"`
+	MemorySize           int32             `json:"memorySize"`
+	CodeSize             int64             `json:"codeSize"`
+	CodeChecksum         string            `json:"codeChecksum"`
+	EnvironmentVariables map[string]string `json:"environmentVariables"`
+	CreatedTime          string            `json:"createdTime"`
+	LastModifiedTime     string            `json:"lastModifiedTime"`
+	FcFunctionRuntimeConfig
+}
+
+// buildFcFunctionRuntimeConfig builds the runtime settings of the function. If update is true,
+// only the changed settings are set.
+func buildFcFunctionRuntimeConfig(d *schema.ResourceData, update bool) FcFunctionRuntimeConfig {
+	var config FcFunctionRuntimeConfig
+	changed := func(key string) bool {
+		if update {
+			return d.HasChange(key)
+		}
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	if changed("initializer") {
+		initializer := d.Get("initializer").(string)
+		config.Initializer = &initializer
+	}
+	for key, field := range map[string]**int32{
+		"initialization_timeout": &config.InitializationTimeout,
+		"instance_concurrency":   &config.InstanceConcurrency,
+		"ca_port":                &config.CAPort,
+	} {
+		if changed(key) {
+			value := int32(d.Get(key).(int))
+			*field = &value
+		}
+	}
+	if changed("custom_container_config") {
+		if containers := d.Get("custom_container_config").([]interface{}); len(containers) > 0 && containers[0] != nil {
+			container := containers[0].(map[string]interface{})
+			config.CustomContainerConfig = &FcCustomContainerConfig{
+				Image:   container["image"].(string),
+				Command: container["command"].(string),
+				Args:    container["args"].(string),
+			}
+		} else if update {
+			// An empty config clears the custom container settings, as a null one leaves them unchanged.
+			config.CustomContainerConfig = &FcCustomContainerConfig{}
+		}
+	}
+	if changed("layers") {
+		config.Layers = []string{}
+		for _, layer := range d.Get("layers").([]interface{}) {
+			config.Layers = append(config.Layers, layer.(string))
+		}
+	}
+	return config
+}
+
+func (client *AliyunClient) DescribeFcFunction(serviceName, functionName string) (*FcFunction, error) {
+	var function FcFunction
+	request := NewFcCommonRequest("/services/%s/functions/%s", serviceName, functionName)
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &function); err != nil {
+		return nil, err
+	}
+	return &function, nil
+}
+
+type FcVersion struct {
+	VersionId        string `json:"versionId"`
+	Description      string `json:"description"`
+	CreatedTime      string `json:"createdTime"`
+	LastModifiedTime string `json:"lastModifiedTime"`
+}
+
+type FcAlias struct {
+	AliasName               string             `json:"aliasName"`
+	VersionId               string             `json:"versionId"`
+	Description             string             `json:"description"`
+	AdditionalVersionWeight map[string]float64 `json:"additionalVersionWeight"`
+	CreatedTime             string             `json:"createdTime,omitempty"`
+	LastModifiedTime        string             `json:"lastModifiedTime,omitempty"`
+}
+
+type FcProvisionConfig struct {
+	Resource string `json:"resource,omitempty"`
+	Target   int    `json:"target"`
+	Current  int    `json:"current,omitempty"`
+}
+
+func (client *AliyunClient) DescribeFcVersion(serviceName, versionId string) (*FcVersion, error) {
+	request := NewFcCommonRequest("/services/%s/versions", serviceName)
+	// The versions are listed backward from the start key, so the first one is the version itself if it exists.
+	request.QueryParams.Set("direction", "BACKWARD")
+	request.QueryParams.Set("startKey", versionId)
+	request.QueryParams.Set("limit", "1")
+
+	var output struct {
+		Versions []FcVersion `json:"versions"`
+	}
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &output); err != nil {
+		return nil, err
+	}
+	if len(output.Versions) < 1 || output.Versions[0].VersionId != versionId {
+		return nil, &fc.ServiceError{
+			ErrorCode:    VersionNotFound,
+			ErrorMessage: fmt.Sprintf("version %s of service %s does not exist", versionId, serviceName),
+		}
+	}
+	return &output.Versions[0], nil
+}
+
+func (client *AliyunClient) DescribeFcAlias(serviceName, aliasName string) (*FcAlias, error) {
+	var alias FcAlias
+	request := NewFcCommonRequest("/services/%s/aliases/%s", serviceName, aliasName)
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &alias); err != nil {
+		return nil, err
+	}
+	return &alias, nil
+}
+
+func (client *AliyunClient) DescribeFcProvisionConfig(serviceName, qualifier, functionName string) (*FcProvisionConfig, error) {
+	var config FcProvisionConfig
+	request := NewFcCommonRequest("/services/%s.%s/functions/%s/provision-config", serviceName, qualifier, functionName)
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+type FcTrigger struct {
+	TriggerName      string          `json:"triggerName"`
+	SourceARN        string          `json:"sourceArn"`
+	TriggerType      string          `json:"triggerType"`
+	InvocationRole   string          `json:"invocationRole"`
+	Qualifier        string          `json:"qualifier"`
+	RawTriggerConfig json.RawMessage `json:"triggerConfig"`
+	CreatedTime      string          `json:"createdTime"`
+	LastModifiedTime string          `json:"lastModifiedTime"`
+}
+
+// DescribeFcTrigger gets the trigger by common request, because the fc-go-sdk neither supports the qualifier
+// nor the trigger types besides oss, log, timer and http.
+func (client *AliyunClient) DescribeFcTrigger(serviceName, functionName, triggerName string) (*FcTrigger, error) {
+	var trigger FcTrigger
+	request := NewFcCommonRequest("/services/%s/functions/%s/triggers/%s", serviceName, functionName, triggerName)
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &trigger); err != nil {
+		return nil, err
+	}
+	return &trigger, nil
+}
+
+type FcCustomDomain struct {
+	DomainName       string         `json:"domainName"`
+	AccountId        string         `json:"accountId,omitempty"`
+	Protocol         string         `json:"protocol"`
+	ApiVersion       string         `json:"apiVersion,omitempty"`
+	RouteConfig      *FcRouteConfig `json:"routeConfig,omitempty"`
+	CertConfig       *FcCertConfig  `json:"certConfig,omitempty"`
+	CreatedTime      string         `json:"createdTime,omitempty"`
+	LastModifiedTime string         `json:"lastModifiedTime,omitempty"`
+}
+
+type FcRouteConfig struct {
+	Routes []FcPathConfig `json:"routes"`
+}
+
+type FcPathConfig struct {
+	Path         string   `json:"path"`
+	ServiceName  string   `json:"serviceName"`
+	FunctionName string   `json:"functionName"`
+	Qualifier    string   `json:"qualifier,omitempty"`
+	Methods      []string `json:"methods,omitempty"`
+}
+
+type FcCertConfig struct {
+	CertName    string `json:"certName"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+}
+
+func (client *AliyunClient) DescribeFcCustomDomain(domainName string) (*FcCustomDomain, error) {
+	var domain FcCustomDomain
+	request := NewFcCommonRequest("/custom-domains/%s", domainName)
+	if err := client.ProcessFcCommonRequest(http.MethodGet, request, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
@@ -0,0 +1,136 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+)
+
+// KmsKeyMetadata contains the key attributes which are not supported by the vendored SDK yet.
+type KmsKeyMetadata struct {
+	kms.KeyMetadata
+	AutomaticRotation string `json:"AutomaticRotation"`
+	RotationInterval  string `json:"RotationInterval"`
+	LastRotationDate  string `json:"LastRotationDate"`
+	NextRotationDate  string `json:"NextRotationDate"`
+}
+
+type describeKmsKeyResponse struct {
+	RequestId   string         `json:"RequestId"`
+	KeyMetadata KmsKeyMetadata `json:"KeyMetadata"`
+}
+
+// convertKmsEncryptionContext converts the terraform map to the JSON string required by KMS Encrypt and Decrypt.
+func convertKmsEncryptionContext(context map[string]interface{}) (string, error) {
+	if len(context) < 1 {
+		return "", nil
+	}
+
+	m := make(map[string]string, len(context))
+	for k, v := range context {
+		m[k] = v.(string)
+	}
+
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("Marshal encryption context %#v got an error: %#v.", context, err)
+	}
+	return string(bytes), nil
+}
+
+func (client *AliyunClient) BuildKmsCommonRequest(action string) *requests.CommonRequest {
+	request := requests.NewCommonRequest()
+
+	endpoint := LoadEndpoint(client.RegionId, KMSCode)
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("kms.%s.aliyuncs.com", client.RegionId)
+	}
+	request.Domain = endpoint
+	request.RegionId = client.RegionId
+	request.Version = KmsApiVersion20160120
+	request.ApiName = action
+
+	return request
+}
+
+func (client *AliyunClient) DescribeKmsKey(keyId string) (key KmsKeyMetadata, err error) {
+	request := client.BuildKmsCommonRequest("DescribeKey")
+	request.QueryParams["KeyId"] = keyId
+
+	raw, err := client.alikmsconn.ProcessCommonRequest(request)
+	if err != nil {
+		return
+	}
+
+	var response describeKmsKeyResponse
+	if err = json.Unmarshal(raw.GetHttpContentBytes(), &response); err != nil {
+		return key, fmt.Errorf("Unmarshal DescribeKey response got an error: %#v.", err)
+	}
+
+	if response.KeyMetadata.KeyId == "" {
+		return key, GetNotFoundErrorFromString(GetNotFoundMessage("KMS Key", keyId))
+	}
+
+	return response.KeyMetadata, nil
+}
+
+func (client *AliyunClient) UpdateKmsKeyRotationPolicy(keyId string, enabled bool, interval string) error {
+	request := client.BuildKmsCommonRequest("UpdateRotationPolicy")
+	request.QueryParams["KeyId"] = keyId
+	request.QueryParams["EnableAutomaticRotation"] = strconv.FormatBool(enabled)
+	if enabled && interval != "" {
+		request.QueryParams["RotationInterval"] = interval
+	}
+
+	_, err := client.alikmsconn.ProcessCommonRequest(request)
+	return err
+}
+
+func (client *AliyunClient) DescribeKmsAlias(name string) (alias kms.Alias, err error) {
+	request := kms.CreateListAliasesRequest()
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+
+	for page := 1; ; page++ {
+		request.PageNumber = requests.NewInteger(page)
+		response, err := client.alikmsconn.ListAliases(request)
+		if err != nil {
+			return alias, err
+		}
+
+		for _, a := range response.Aliases.Alias {
+			if a.AliasName == name {
+				return a, nil
+			}
+		}
+
+		if len(response.Aliases.Alias) < PageSizeLarge {
+			break
+		}
+	}
+
+	return alias, GetNotFoundErrorFromString(GetNotFoundMessage("KMS Alias", name))
+}
+
+// kmsRotationIntervalInSeconds converts a rotation interval like "365d" or "31536000s" to seconds.
+func kmsRotationIntervalInSeconds(interval string) (int, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("invalid rotation interval %q", interval)
+	}
+
+	value, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid rotation interval %q", interval)
+	}
+
+	switch strings.ToLower(interval[len(interval)-1:]) {
+	case "d":
+		return value * 24 * 3600, nil
+	case "s":
+		return value, nil
+	}
+	return 0, fmt.Errorf("invalid rotation interval %q", interval)
+}
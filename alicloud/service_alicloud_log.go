@@ -0,0 +1,476 @@
+package alicloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// ProcessSlsCommonRequest signs and sends the request of the log service API which is not supported by the
+// vendored aliyun-log-go-sdk, like saved searches, dashboards and alerts. The payload is encoded as JSON if
+// it is not nil, and the response body is decoded into the output if it is not nil.
+func (client *AliyunClient) ProcessSlsCommonRequest(project, method, uri string, payload interface{}, output interface{}) error {
+	conn := client.slsconn
+	endpoint := strings.TrimPrefix(strings.TrimPrefix(conn.Endpoint, "https://"), "http://")
+	host := endpoint
+	if project != "" {
+		host = project + "." + endpoint
+	}
+
+	var body []byte
+	headers := map[string]string{
+		"Host":                  host,
+		"Date":                  time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123),
+		"x-log-apiversion":      "0.6.0",
+		"x-log-signaturemethod": "hmac-sha1",
+		"x-log-bodyrawsize":     "0",
+	}
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = b
+		headers["Content-Type"] = "application/json"
+		headers["Content-MD5"] = fmt.Sprintf("%X", md5.Sum(body))
+		headers["x-log-bodyrawsize"] = strconv.Itoa(len(body))
+	}
+	if conn.SecurityToken != "" {
+		headers["x-acs-security-token"] = conn.SecurityToken
+	}
+	digest, err := slsSignature(conn.AccessKeySecret, method, uri, headers)
+	if err != nil {
+		return err
+	}
+	headers["Authorization"] = fmt.Sprintf("SLS %s:%s", conn.AccessKeyID, digest)
+
+	scheme := "http://"
+	if strings.HasPrefix(conn.Endpoint, "https://") && !sls.GlobalForceUsingHTTP {
+		scheme = "https://"
+	}
+	req, err := http.NewRequest(method, scheme+host+uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	httpClient := &http.Client{Timeout: DefaultTimeout * time.Second}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	buf, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		slsError := &sls.Error{
+			HTTPCode:  int32(resp.StatusCode),
+			RequestID: resp.Header.Get("x-log-requestid"),
+		}
+		json.Unmarshal(buf, slsError)
+		return slsError
+	}
+
+	if output != nil && len(buf) > 0 {
+		return json.Unmarshal(buf, output)
+	}
+	return nil
+}
+
+// slsSignature calculates the signature digest of the request in the same way as the aliyun-log-go-sdk.
+func slsSignature(accessKeySecret, method, uri string, headers map[string]string) (string, error) {
+	var slsHeaderKeys []string
+	slsHeaders := make(map[string]string)
+	for k, v := range headers {
+		l := strings.TrimSpace(strings.ToLower(k))
+		if strings.HasPrefix(l, "x-log-") || strings.HasPrefix(l, "x-acs-") {
+			slsHeaders[l] = strings.TrimSpace(v)
+			slsHeaderKeys = append(slsHeaderKeys, l)
+		}
+	}
+	sort.Strings(slsHeaderKeys)
+	var canoHeaders []string
+	for _, k := range slsHeaderKeys {
+		canoHeaders = append(canoHeaders, k+":"+slsHeaders[k])
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	canoResource := u.EscapedPath()
+	if u.RawQuery != "" {
+		vals := u.Query()
+		var keys []string
+		for k := range vals {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var params []string
+		for _, k := range keys {
+			params = append(params, k+"="+strings.Join(vals[k], ""))
+		}
+		canoResource += "?" + strings.Join(params, "&")
+	}
+
+	signStr := strings.Join([]string{method, headers["Content-MD5"], headers["Content-Type"], headers["Date"],
+		strings.Join(canoHeaders, "\n"), canoResource}, "\n")
+	mac := hmac.New(sha1.New, []byte(accessKeySecret))
+	if _, err := mac.Write([]byte(signStr)); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+type LogSavedSearch struct {
+	SavedSearchName string `json:"savedsearchName"`
+	SearchQuery     string `json:"searchQuery"`
+	Logstore        string `json:"logstore"`
+	Topic           string `json:"topic"`
+	DisplayName     string `json:"displayName"`
+}
+
+type LogDashboard struct {
+	DashboardName string `json:"dashboardName"`
+	DisplayName   string `json:"displayName"`
+	Description   string `json:"description"`
+	// Charts is a JSON list of LogChart. It is kept raw so that the chart settings not in LogChart are not dropped.
+	Charts json.RawMessage `json:"charts"`
+}
+
+type LogChart struct {
+	Title   string          `json:"title"`
+	Type    string          `json:"type"`
+	Search  LogChartSearch  `json:"search"`
+	Display LogChartDisplay `json:"display"`
+}
+
+type LogChartSearch struct {
+	Logstore string `json:"logstore"`
+	Topic    string `json:"topic"`
+	Query    string `json:"query"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+type LogChartDisplay struct {
+	XAxis       []string `json:"xAxis"`
+	YAxis       []string `json:"yAxis"`
+	XPos        int      `json:"xPos"`
+	YPos        int      `json:"yPos"`
+	Width       int      `json:"width"`
+	Height      int      `json:"height"`
+	DisplayName string   `json:"displayName"`
+}
+
+type LogAlert struct {
+	Name          string                `json:"name"`
+	DisplayName   string                `json:"displayName"`
+	Description   string                `json:"description"`
+	Type          string                `json:"type"`
+	State         string                `json:"state,omitempty"`
+	Schedule      LogAlertSchedule      `json:"schedule"`
+	Configuration LogAlertConfiguration `json:"configuration"`
+}
+
+type LogAlertSchedule struct {
+	Type     string `json:"type"`
+	Interval string `json:"interval"`
+}
+
+type LogAlertConfiguration struct {
+	Condition        string                 `json:"condition"`
+	Dashboard        string                 `json:"dashboard"`
+	QueryList        []LogAlertQuery        `json:"queryList"`
+	NotificationList []LogAlertNotification `json:"notificationList"`
+	NotifyThreshold  int                    `json:"notifyThreshold"`
+	Throttling       string                 `json:"throttling"`
+}
+
+type LogAlertQuery struct {
+	ChartTitle   string `json:"chartTitle"`
+	LogStore     string `json:"logStore"`
+	Query        string `json:"query"`
+	TimeSpanType string `json:"timeSpanType"`
+	Start        string `json:"start"`
+	End          string `json:"end"`
+}
+
+type LogAlertNotification struct {
+	Type       string            `json:"type"`
+	Content    string            `json:"content"`
+	ServiceUri string            `json:"serviceUri,omitempty"`
+	EmailList  []string          `json:"emailList,omitempty"`
+	Method     string            `json:"method,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+func (client *AliyunClient) DescribeLogSavedSearch(projectName, searchName string) (*LogSavedSearch, error) {
+	var search LogSavedSearch
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, "/savedsearches/"+url.PathEscape(searchName), nil, &search); err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+func (client *AliyunClient) DescribeLogDashboard(projectName, dashboardName string) (*LogDashboard, error) {
+	var dashboard LogDashboard
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, "/dashboards/"+url.PathEscape(dashboardName), nil, &dashboard); err != nil {
+		return nil, err
+	}
+	return &dashboard, nil
+}
+
+func (client *AliyunClient) DescribeLogAlert(projectName, alertName string) (*LogAlert, error) {
+	var alert LogAlert
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, "/jobs/"+url.PathEscape(alertName), nil, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// LogStoreDetail is the logstore with the options which are not supported by the aliyun-log-go-sdk.
+type LogStoreDetail struct {
+	Name           string `json:"logstoreName"`
+	TTL            int    `json:"ttl"`
+	ShardCount     int    `json:"shardCount"`
+	AutoSplit      bool   `json:"autoSplit"`
+	MaxSplitShard  int    `json:"maxSplitShard,omitempty"`
+	EnableTracking bool   `json:"enable_tracking"`
+	AppendMeta     bool   `json:"appendMeta"`
+}
+
+func (client *AliyunClient) DescribeLogStore(projectName, storeName string) (*LogStoreDetail, error) {
+	var store LogStoreDetail
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, "/logstores/"+url.PathEscape(storeName), nil, &store); err != nil {
+		return nil, err
+	}
+	return &store, nil
+}
+
+// DescribeLogStoreReadWriteShards returns the shards which are in the status readwrite, sorted by the hash range.
+func (client *AliyunClient) DescribeLogStoreReadWriteShards(projectName, storeName string) ([]*sls.Shard, error) {
+	shards, err := client.slsconn.ListShards(projectName, storeName)
+	if err != nil {
+		return nil, err
+	}
+	var readWrite []*sls.Shard
+	for _, shard := range shards {
+		if shard.Status == LogShardReadWrite {
+			readWrite = append(readWrite, shard)
+		}
+	}
+	sort.Slice(readWrite, func(i, j int) bool {
+		return readWrite[i].InclusiveBeginKey < readWrite[j].InclusiveBeginKey
+	})
+	return readWrite, nil
+}
+
+// ScaleLogStoreShards splits or merges the readwrite shards one by one until there are the expected count.
+// The shard with the widest hash range is split in the middle, and the adjacent pair with the narrowest
+// combined hash range is merged, so the hash ranges are kept as even as possible.
+func (client *AliyunClient) ScaleLogStoreShards(projectName, storeName string, count int) error {
+	return resource.Retry(DefaultTimeoutMedium*time.Second, func() *resource.RetryError {
+		shards, err := client.DescribeLogStoreReadWriteShards(projectName, storeName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(shards) == count {
+			return nil
+		}
+
+		if len(shards) < count {
+			var widest *sls.Shard
+			var widestRange *big.Int
+			for _, shard := range shards {
+				r, err := logShardHashRange(shard.InclusiveBeginKey, shard.ExclusiveBeginKey)
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+				if widestRange == nil || r.Cmp(widestRange) > 0 {
+					widest, widestRange = shard, r
+				}
+			}
+			splitKey, err := logShardSplitKey(widest.InclusiveBeginKey, widest.ExclusiveBeginKey)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := client.slsconn.SplitShard(projectName, storeName, widest.ShardID, splitKey); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Splitting shard %d got an error: %#v", widest.ShardID, err))
+			}
+		} else {
+			var narrowest *sls.Shard
+			var narrowestRange *big.Int
+			for i := 0; i < len(shards)-1; i++ {
+				r, err := logShardHashRange(shards[i].InclusiveBeginKey, shards[i+1].ExclusiveBeginKey)
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+				if narrowestRange == nil || r.Cmp(narrowestRange) < 0 {
+					narrowest, narrowestRange = shards[i], r
+				}
+			}
+			if _, err := client.slsconn.MergeShards(projectName, storeName, narrowest.ShardID); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Merging shard %d got an error: %#v", narrowest.ShardID, err))
+			}
+		}
+
+		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(fmt.Errorf("Scaling the shards of log store %s from %d to %d timeout.", storeName, len(shards), count))
+	})
+}
+
+func logShardHashRange(beginKey, endKey string) (*big.Int, error) {
+	begin, ok := new(big.Int).SetString(beginKey, 16)
+	if !ok {
+		return nil, fmt.Errorf("Invalid shard hash key %s.", beginKey)
+	}
+	end, ok := new(big.Int).SetString(endKey, 16)
+	if !ok {
+		return nil, fmt.Errorf("Invalid shard hash key %s.", endKey)
+	}
+	return end.Sub(end, begin), nil
+}
+
+// logShardSplitKey returns the middle of the hash range [beginKey, endKey) as a 32 characters hex string.
+func logShardSplitKey(beginKey, endKey string) (string, error) {
+	r, err := logShardHashRange(beginKey, endKey)
+	if err != nil {
+		return "", err
+	}
+	if r.Cmp(big.NewInt(1)) <= 0 {
+		return "", fmt.Errorf("The hash range of the shard from %s to %s is too narrow to split.", beginKey, endKey)
+	}
+	begin, _ := new(big.Int).SetString(beginKey, 16)
+	return fmt.Sprintf("%032x", begin.Add(begin, r.Rsh(r, 1))), nil
+}
+
+type LogOssShipper struct {
+	ShipperName         string                    `json:"shipperName"`
+	TargetType          string                    `json:"targetType"`
+	TargetConfiguration LogOssShipperTargetConfig `json:"targetConfiguration"`
+}
+
+type LogOssShipperTargetConfig struct {
+	OssBucket      string               `json:"ossBucket"`
+	OssPrefix      string               `json:"ossPrefix"`
+	RoleArn        string               `json:"roleArn"`
+	BufferInterval int                  `json:"bufferInterval"`
+	BufferSize     int                  `json:"bufferSize"`
+	CompressType   string               `json:"compressType"`
+	PathFormat     string               `json:"pathFormat"`
+	Storage        LogOssShipperStorage `json:"storage"`
+}
+
+type LogOssShipperStorage struct {
+	Format string                 `json:"format"`
+	Detail map[string]interface{} `json:"detail"`
+}
+
+type LogOdpsShipper struct {
+	ShipperName         string                     `json:"shipperName"`
+	TargetType          string                     `json:"targetType"`
+	TargetConfiguration LogOdpsShipperTargetConfig `json:"targetConfiguration"`
+}
+
+type LogOdpsShipperTargetConfig struct {
+	OdpsEndpoint        string   `json:"odpsEndpoint"`
+	OdpsProject         string   `json:"odpsProject"`
+	OdpsTable           string   `json:"odpsTable"`
+	Fields              []string `json:"fields"`
+	PartitionColumn     []string `json:"partitionColumn"`
+	PartitionTimeFormat string   `json:"partitionTimeFormat"`
+	BufferInterval      int      `json:"bufferInterval"`
+}
+
+type LogEtlJob struct {
+	Name          string              `json:"name"`
+	DisplayName   string              `json:"displayName"`
+	Description   string              `json:"description"`
+	Type          string              `json:"type"`
+	State         string              `json:"state,omitempty"`
+	Status        string              `json:"status,omitempty"`
+	Schedule      LogAlertSchedule    `json:"schedule"`
+	Configuration LogEtlConfiguration `json:"configuration"`
+}
+
+type LogEtlConfiguration struct {
+	Logstore   string            `json:"logstore"`
+	Script     string            `json:"script"`
+	RoleArn    string            `json:"roleArn"`
+	FromTime   int64             `json:"fromTime"`
+	ToTime     int64             `json:"toTime"`
+	Version    int               `json:"version"`
+	Parameters map[string]string `json:"parameters"`
+	Sinks      []LogEtlSink      `json:"sinks"`
+}
+
+type LogEtlSink struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Project  string `json:"project"`
+	Logstore string `json:"logstore"`
+	Endpoint string `json:"endpoint,omitempty"`
+	RoleArn  string `json:"roleArn"`
+}
+
+func (client *AliyunClient) DescribeLogOssShipper(projectName, storeName, shipperName string) (*LogOssShipper, error) {
+	var shipper LogOssShipper
+	uri := fmt.Sprintf("/logstores/%s/shipper/%s", url.PathEscape(storeName), url.PathEscape(shipperName))
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, uri, nil, &shipper); err != nil {
+		return nil, err
+	}
+	return &shipper, nil
+}
+
+func (client *AliyunClient) DescribeLogOdpsShipper(projectName, storeName, shipperName string) (*LogOdpsShipper, error) {
+	var shipper LogOdpsShipper
+	uri := fmt.Sprintf("/logstores/%s/shipper/%s", url.PathEscape(storeName), url.PathEscape(shipperName))
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, uri, nil, &shipper); err != nil {
+		return nil, err
+	}
+	return &shipper, nil
+}
+
+func (client *AliyunClient) DescribeLogEtlJob(projectName, etlName string) (*LogEtlJob, error) {
+	var job LogEtlJob
+	if err := client.ProcessSlsCommonRequest(projectName, http.MethodGet, "/jobs/"+url.PathEscape(etlName), nil, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (client *AliyunClient) DescribeLogConsumerGroup(projectName, storeName, groupName string) (*sls.ConsumerGroup, error) {
+	groups, err := client.slsconn.ListConsumerGroup(projectName, storeName)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.ConsumerGroupName == groupName {
+			return group, nil
+		}
+	}
+	return nil, &sls.Error{
+		Code:    ConsumerGroupNotFound,
+		Message: fmt.Sprintf("consumer group %s of logstore %s does not exist", groupName, storeName),
+	}
+}
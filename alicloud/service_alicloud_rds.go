@@ -1,13 +1,16 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 //
@@ -341,6 +344,183 @@ func (client *AliyunClient) DescribeBackupPolicy(instanceId string) (policy *rds
 	return client.rdsconn.DescribeBackupPolicy(request)
 }
 
+func (client *AliyunClient) DescribeParameters(instanceId string) (*rds.DescribeParametersResponse, error) {
+
+	request := rds.CreateDescribeParametersRequest()
+	request.DBInstanceId = instanceId
+
+	return client.rdsconn.DescribeParameters(request)
+}
+
+// ParametersRequireRestart returns true if any of the given parameters can only take effect after restarting the instance.
+func (client *AliyunClient) ParametersRequireRestart(engine, engineVersion string, names []string) (bool, error) {
+	request := rds.CreateDescribeParameterTemplatesRequest()
+	request.Engine = engine
+	request.EngineVersion = engineVersion
+
+	resp, err := client.rdsconn.DescribeParameterTemplates(request)
+	if err != nil {
+		return false, fmt.Errorf("DescribeParameterTemplates got an error: %#v.", err)
+	}
+
+	restart := make(map[string]bool)
+	for _, record := range resp.Parameters.TemplateRecord {
+		restart[record.ParameterName] = strings.ToLower(record.ForceRestart) == "true"
+	}
+	for _, name := range names {
+		if restart[name] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ModifyParameters applies the changed items of the parameter set attribute and waits for the instance restarting
+// when one of them requires it.
+func (client *AliyunClient) ModifyParameters(d *schema.ResourceData, attribute string) error {
+	o, n := d.GetChange(attribute)
+	added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+	if len(added) < 1 {
+		return nil
+	}
+
+	config := make(map[string]string)
+	var names []string
+	for _, i := range added {
+		parameter := i.(map[string]interface{})
+		config[parameter["name"].(string)] = parameter["value"].(string)
+		names = append(names, parameter["name"].(string))
+	}
+	cfg, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("Marshal parameters %#v got an error: %#v.", config, err)
+	}
+
+	request := rds.CreateModifyParameterRequest()
+	request.DBInstanceId = d.Id()
+	request.Parameters = string(cfg)
+
+	// The engine is not in the configuration of a readonly instance, so it is got from the instance itself.
+	instance, err := client.DescribeDBInstanceById(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeDBInstanceById %s got an error: %#v.", d.Id(), err)
+	}
+	forceRestart, err := client.ParametersRequireRestart(instance.Engine, instance.EngineVersion, names)
+	if err != nil {
+		return err
+	}
+	request.Forcerestart = requests.NewBoolean(forceRestart)
+
+	// wait instance status is running before modifying
+	if err := client.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := client.rdsconn.ModifyParameter(request); err != nil {
+			if IsExceptedError(err, OperationDeniedDBInstanceStatus) {
+				return resource.RetryableError(fmt.Errorf("ModifyParameter got an error: %#v.", err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("ModifyParameter got an error: %#v.", err))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !forceRestart {
+		return nil
+	}
+
+	// Some parameters need restarting instance and the status would be changed after a while.
+	time.Sleep(DefaultIntervalMedium * time.Second)
+	if err := client.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+		return fmt.Errorf("WaitForInstance %s got error: %#v", Running, err)
+	}
+
+	return nil
+}
+
+// RefreshParameters only reads back the parameters which have been specified in the parameter set attribute.
+func (client *AliyunClient) RefreshParameters(d *schema.ResourceData, attribute string) error {
+	var param []map[string]interface{}
+
+	documented, ok := d.GetOk(attribute)
+	if !ok {
+		d.Set(attribute, param)
+		return nil
+	}
+
+	resp, err := client.DescribeParameters(d.Id())
+	if err != nil {
+		return fmt.Errorf("DescribeParameters got an error: %#v.", err)
+	}
+
+	parameters := make(map[string]string)
+	for _, p := range resp.ConfigParameters.DBInstanceParameter {
+		parameters[p.ParameterName] = p.ParameterValue
+	}
+	for _, p := range resp.RunningParameters.DBInstanceParameter {
+		parameters[p.ParameterName] = p.ParameterValue
+	}
+
+	for _, i := range documented.(*schema.Set).List() {
+		name := i.(map[string]interface{})["name"].(string)
+		if value, ok := parameters[name]; ok {
+			param = append(param, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+
+	return d.Set(attribute, param)
+}
+
+func (client *AliyunClient) DescribeDBInstanceSSL(instanceId string) (*rds.DescribeDBInstanceSSLResponse, error) {
+
+	request := rds.CreateDescribeDBInstanceSSLRequest()
+	request.DBInstanceId = instanceId
+
+	return client.rdsconn.DescribeDBInstanceSSL(request)
+}
+
+func (client *AliyunClient) DescribeDBInstanceTDE(instanceId string) (*rds.DescribeDBInstanceTDEResponse, error) {
+
+	request := rds.CreateDescribeDBInstanceTDERequest()
+	request.DBInstanceId = instanceId
+
+	return client.rdsconn.DescribeDBInstanceTDE(request)
+}
+
+func (client *AliyunClient) DescribeSQLCollectorPolicy(instanceId string) (*rds.DescribeSQLCollectorPolicyResponse, error) {
+
+	request := rds.CreateDescribeSQLCollectorPolicyRequest()
+	request.DBInstanceId = instanceId
+
+	return client.rdsconn.DescribeSQLCollectorPolicy(request)
+}
+
+func (client *AliyunClient) DescribeDBBackups(request *rds.DescribeBackupsRequest) (backups []rds.Backup, err error) {
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for page := 1; ; page++ {
+		request.PageNumber = requests.NewInteger(page)
+		resp, err := client.rdsconn.DescribeBackups(request)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil || len(resp.Items.Backup) < 1 {
+			break
+		}
+		backups = append(backups, resp.Items.Backup...)
+		if len(resp.Items.Backup) < PageSizeLarge {
+			break
+		}
+	}
+	return
+}
+
 // WaitForInstance waits for instance to given status
 func (client *AliyunClient) WaitForDBInstance(instanceId string, status Status, timeout int) error {
 	if timeout <= 0 {
@@ -905,6 +905,15 @@ func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validateJsonContent only checks the value is a valid JSON, so it can be written in any format, like a heredoc string.
+func validateJsonContent(v interface{}, k string) (ws []string, errors []error) {
+	var j interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &j); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	}
+	return
+}
+
 func validatePolicyType(v interface{}, k string) (ws []string, errors []error) {
 	value := ram.Type(v.(string))
 
@@ -974,6 +983,17 @@ func validateContainerNamePrefix(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+func validateKubernetesVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	reg := regexp.MustCompile(`^[1-9][0-9]*\.[0-9]+\.[0-9]+(-aliyun\.[0-9]+)?$`)
+	if !reg.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a kubernetes version like 1.11.5 or 1.11.5-aliyun.1, got %s.", k, value))
+	}
+
+	return
+}
+
 func validateContainerAppName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -1192,6 +1212,43 @@ func validateKmsKeyStatus(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+func validateKmsRotationInterval(v interface{}, k string) (ws []string, errors []error) {
+	seconds, err := kmsRotationIntervalInSeconds(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number with unit 'd' or 's', like 365d or 31536000s, got %s.", k, v.(string)))
+		return
+	}
+	if seconds < 7*24*3600 || seconds > 730*24*3600 {
+		errors = append(errors, fmt.Errorf("%q must be between 7 days and 730 days, got %s.", k, v.(string)))
+	}
+	return
+}
+
+func validateKmsAliasName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "alias/") || len(value) <= len("alias/") {
+		errors = append(errors, fmt.Errorf("%q must start with 'alias/' and be followed by a name, got %s.", k, value))
+	}
+	if strings.HasPrefix(value, "alias/acs/") {
+		errors = append(errors, fmt.Errorf("%q cannot start with 'alias/acs/' which is reserved by Alibaba Cloud.", k))
+	}
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters.", k))
+	}
+	return
+}
+
+func validateDBTime(layout string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if _, err := time.Parse(layout, value); err != nil {
+			errors = append(errors, fmt.Errorf(
+				"%q must be a UTC time in the format %s, got %s.", k, layout, value))
+		}
+		return
+	}
+}
+
 func validateNatGatewaySpec(v interface{}, k string) (ws []string, errors []error) {
 	spec := ecs.NatGatewaySpec(v.(string))
 	if spec != ecs.NatGatewaySmallSpec && spec != ecs.NatGatewayMiddleSpec && spec != ecs.NatGatewayLargeSpec {
@@ -1219,3 +1276,23 @@ func validateCommonResourceName(v interface{}, k string) (ws []string, errors []
 
 	return
 }
+
+func validateEssNotificationArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	reg := regexp.MustCompile(`^acs:ess:[a-z0-9-]+:[0-9]+:(cloudmonitor|(queue|topic|oos)/.+)$`)
+	if !reg.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be like acs:ess:{region}:{account-id}:queue/{queue-name}, "+
+			"acs:ess:{region}:{account-id}:topic/{topic-name}, acs:ess:{region}:{account-id}:oos/{template-name} or acs:ess:{region}:{account-id}:cloudmonitor, got %s.", k, value))
+	}
+
+	return
+}
+
+func validateFloatString(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number, got %s.", k, value))
+	}
+	return
+}
@@ -482,3 +482,139 @@ func TestValidateIntegerInRange(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateKmsRotationInterval(t *testing.T) {
+	validIntervals := []string{"7d", "365d", "730d", "604800s", "31536000s"}
+	for _, v := range validIntervals {
+		_, errors := validateKmsRotationInterval(v, "rotation_interval")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid rotation interval: %q", v, errors)
+		}
+	}
+
+	invalidIntervals := []string{"", "d", "6d", "731d", "100s", "365", "1y"}
+	for _, v := range invalidIntervals {
+		_, errors := validateKmsRotationInterval(v, "rotation_interval")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid rotation interval", v)
+		}
+	}
+}
+
+func TestValidateKmsAliasName(t *testing.T) {
+	validNames := []string{"alias/example", "alias/app/db"}
+	for _, v := range validNames {
+		_, errors := validateKmsAliasName(v, "alias_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid alias name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{"example", "alias/", "alias/acs/rds"}
+	for _, v := range invalidNames {
+		_, errors := validateKmsAliasName(v, "alias_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid alias name", v)
+		}
+	}
+}
+
+func TestValidateDBTime(t *testing.T) {
+	validTimes := []string{"2018-06-01T08:30:00Z", "2018-12-31T23:59:59Z"}
+	for _, v := range validTimes {
+		_, errors := validateDBTime(DBRestoreTimeLayout)(v, "restore_time")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid restore time: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{"", "2018-06-01", "2018-06-01T08:30Z", "2018-06-01 08:30:00", "2018-13-01T08:30:00Z"}
+	for _, v := range invalidTimes {
+		_, errors := validateDBTime(DBRestoreTimeLayout)(v, "restore_time")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid restore time", v)
+		}
+	}
+
+	_, errors := validateDBTime(DBBackupTimeLayout)("2018-06-01T08:30Z", "start_time")
+	if len(errors) != 0 {
+		t.Fatalf("2018-06-01T08:30Z should be a valid backup time: %q", errors)
+	}
+}
+
+func TestValidateJsonContent(t *testing.T) {
+	validJsons := []string{"{}", "[]", `{"key":"value"}`, "[\n  {\n    \"title\": \"chart\"\n  }\n]\n"}
+	for _, v := range validJsons {
+		_, errors := validateJsonContent(v, "chart_list")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid JSON: %q", v, errors)
+		}
+	}
+
+	invalidJsons := []string{"", "{", `{"key":}`, "[1,]"}
+	for _, v := range invalidJsons {
+		_, errors := validateJsonContent(v, "chart_list")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid JSON", v)
+		}
+	}
+}
+
+func TestValidateKubernetesVersion(t *testing.T) {
+	validVersions := []string{"1.9.3", "1.11.5", "1.12.6-aliyun.1"}
+	for _, v := range validVersions {
+		_, errors := validateKubernetesVersion(v, "kubernetes_version")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid kubernetes version: %q", v, errors)
+		}
+	}
+
+	invalidVersions := []string{"", "1.11", "v1.11.5", "1.11.5-beta", "0.1.0"}
+	for _, v := range invalidVersions {
+		_, errors := validateKubernetesVersion(v, "kubernetes_version")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid kubernetes version", v)
+		}
+	}
+}
+
+func TestValidateEssNotificationArn(t *testing.T) {
+	validArns := []string{
+		"acs:ess:cn-hangzhou:1111111111:queue/tf-test",
+		"acs:ess:cn-hangzhou:1111111111:topic/tf-test",
+		"acs:ess:cn-hangzhou:1111111111:oos/tf-test",
+		"acs:ess:cn-hangzhou:1111111111:cloudmonitor",
+	}
+	for _, v := range validArns {
+		_, errors := validateEssNotificationArn(v, "notification_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid notification arn: %q", v, errors)
+		}
+	}
+
+	invalidArns := []string{"", "acs:mns:cn-hangzhou:1111111111:queue/tf-test", "acs:ess:cn-hangzhou:account:queue/tf-test", "acs:ess:cn-hangzhou:1111111111:queue/"}
+	for _, v := range invalidArns {
+		_, errors := validateEssNotificationArn(v, "notification_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid notification arn", v)
+		}
+	}
+}
+
+func TestValidateFloatString(t *testing.T) {
+	validValues := []string{"0", "-10", "10.5", "1e3"}
+	for _, v := range validValues {
+		_, errors := validateFloatString(v, "metric_interval_lower_bound")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid number: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{"", "ten", "10%"}
+	for _, v := range invalidValues {
+		_, errors := validateFloatString(v, "metric_interval_lower_bound")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid number", v)
+		}
+	}
+}